@@ -0,0 +1,30 @@
+resource "thalassa_container_registry_namespace" "app" {
+  region    = "nl-01"
+  namespace = "app"
+}
+
+resource "thalassa_container_registry_namespace_configuration" "app" {
+  namespace_id = thalassa_container_registry_namespace.app.id
+
+  retention_policy {
+    enabled = true
+
+    rule {
+      count = 10
+    }
+  }
+
+  # Apply a changed retention policy right away
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.thalassa_container_registry_retention_policy_run.app]
+    }
+  }
+}
+
+action "thalassa_container_registry_retention_policy_run" "app" {
+  config {
+    namespace_id = thalassa_container_registry_namespace.app.id
+  }
+}
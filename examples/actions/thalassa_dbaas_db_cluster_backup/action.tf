@@ -0,0 +1,29 @@
+variable "db_cluster_id" {
+  type = string
+}
+
+variable "owner_role_id" {
+  type = string
+}
+
+action "thalassa_dbaas_db_cluster_backup" "pre_change" {
+  config {
+    db_cluster_id    = var.db_cluster_id
+    description      = "Backup before applying database changes"
+    retention_policy = "14d"
+  }
+}
+
+# Back up the cluster before a database is created or changed
+resource "thalassa_dbaas_pg_database" "app" {
+  db_cluster_id = var.db_cluster_id
+  name          = "app"
+  owner_role_id = var.owner_role_id
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.thalassa_dbaas_db_cluster_backup.pre_change]
+    }
+  }
+}
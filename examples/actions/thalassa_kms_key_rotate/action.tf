@@ -0,0 +1,14 @@
+resource "thalassa_kms_key" "app" {
+  region   = "nl-01"
+  name     = "app-secrets"
+  key_type = "aes256-gcm96"
+}
+
+# Rotate the key on demand:
+#   terraform apply -invoke=action.thalassa_kms_key_rotate.app
+action "thalassa_kms_key_rotate" "app" {
+  config {
+    region = thalassa_kms_key.app.region
+    key_id = thalassa_kms_key.app.id
+  }
+}
@@ -0,0 +1,22 @@
+variable "cluster_id" {
+  type = string
+}
+
+variable "node_pool_id" {
+  type = string
+}
+
+variable "machine_id" {
+  type        = string
+  description = "Node pool machine to replace, e.g. a node with a failing disk"
+}
+
+# Replace the machine on demand:
+#   terraform apply -invoke=action.thalassa_kubernetes_node_pool_machine_replace.worker -var machine_id=...
+action "thalassa_kubernetes_node_pool_machine_replace" "worker" {
+  config {
+    cluster_id   = var.cluster_id
+    node_pool_id = var.node_pool_id
+    machine_id   = var.machine_id
+  }
+}
@@ -0,0 +1,29 @@
+variable "machine_id" {
+  type = string
+}
+
+variable "app_config_version" {
+  type        = string
+  description = "Version of the application configuration on the machine"
+}
+
+action "thalassa_machine_restart" "app" {
+  config {
+    machine_id = var.machine_id
+  }
+}
+
+# Restart the machine whenever the application configuration version changes
+resource "terraform_data" "app_config" {
+  input = var.app_config_version
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.thalassa_machine_restart.app]
+    }
+  }
+}
+
+# Or restart it on demand:
+#   terraform apply -invoke=action.thalassa_machine_restart.app
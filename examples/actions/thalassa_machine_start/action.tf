@@ -0,0 +1,11 @@
+variable "machine_id" {
+  type = string
+}
+
+# Start the machine on demand:
+#   terraform apply -invoke=action.thalassa_machine_start.app
+action "thalassa_machine_start" "app" {
+  config {
+    machine_id = var.machine_id
+  }
+}
@@ -0,0 +1,11 @@
+variable "machine_id" {
+  type = string
+}
+
+# Stop the machine on demand:
+#   terraform apply -invoke=action.thalassa_machine_stop.app
+action "thalassa_machine_stop" "app" {
+  config {
+    machine_id = var.machine_id
+  }
+}
@@ -0,0 +1,24 @@
+variable "run_started_at" {
+  description = "Start of the Terraform run (RFC3339)"
+  type        = string
+}
+
+# Audit trail of everything the CI service account changed during this run
+data "thalassa_audit_logs" "run" {
+  start_time         = var.run_started_at
+  service_account_id = "sa-abc123"
+  actions            = ["create", "update", "delete"]
+}
+
+# Audit trail of a single resource
+data "thalassa_audit_logs" "vpc" {
+  resource_id = thalassa_vpc.main.id
+  max_results = 50
+}
+
+output "audit_trail" {
+  value = [
+    for log in data.thalassa_audit_logs.run.logs :
+    "${log.created_at} ${log.action} ${log.resource_type}/${log.resource_id}"
+  ]
+}
@@ -0,0 +1,10 @@
+data "thalassa_block_volumes" "backups" {
+  region = "nl-01"
+  label_selector = {
+    backup = "true"
+  }
+}
+
+output "backup_volume_sizes" {
+  value = { for volume in data.thalassa_block_volumes.backups.block_volumes : volume.name => volume.size_gb }
+}
@@ -0,0 +1,28 @@
+data "thalassa_cloud_init_config" "web" {
+  part {
+    content = <<-EOT
+      #cloud-config
+      packages:
+        - nginx
+    EOT
+  }
+
+  part {
+    content_type = "text/x-shellscript"
+    filename     = "setup.sh"
+    content      = <<-EOT
+      #!/bin/sh
+      systemctl enable --now nginx
+    EOT
+  }
+}
+
+resource "thalassa_virtual_machine_instance" "web" {
+  name                = "web"
+  subnet_id           = thalassa_subnet.example.id
+  machine_type        = "pgp-small"
+  machine_image       = data.thalassa_machine_image.ubuntu.name
+  root_volume_size_gb = 20
+  root_volume_type    = data.thalassa_volume_type.block.id
+  cloud_init          = data.thalassa_cloud_init_config.web.rendered
+}
@@ -0,0 +1,8 @@
+data "thalassa_container_registry_namespace" "platform" {
+  namespace = "platform"
+  region    = "nl-01"
+}
+
+output "platform_namespace_size_bytes" {
+  value = data.thalassa_container_registry_namespace.platform.total_size_bytes
+}
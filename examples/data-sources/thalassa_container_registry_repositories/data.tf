@@ -0,0 +1,15 @@
+data "thalassa_container_registry_namespace" "platform" {
+  namespace = "platform"
+}
+
+data "thalassa_container_registry_repositories" "platform" {
+  namespace_id = data.thalassa_container_registry_namespace.platform.id
+  include_tags = true
+}
+
+output "repository_tags" {
+  value = {
+    for repo in data.thalassa_container_registry_repositories.platform.repositories :
+    repo.full_name => [for tag in repo.tags : tag.tag]
+  }
+}
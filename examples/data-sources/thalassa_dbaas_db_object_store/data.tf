@@ -0,0 +1,16 @@
+# Look up an object store managed in another configuration.
+data "thalassa_dbaas_db_object_store" "backups" {
+  name   = "shared-db-backups"
+  region = "nl-01"
+}
+
+resource "thalassa_dbaas_db_cluster" "analytics" {
+  name                   = "analytics"
+  subnet_id              = thalassa_subnet.example.id
+  database_instance_type = "db-pgp-small"
+  engine                 = "postgres"
+  engine_version         = "15.13"
+  allocated_storage      = 100
+  volume_type_class      = "block"
+  db_object_store_id     = data.thalassa_dbaas_db_object_store.backups.id
+}
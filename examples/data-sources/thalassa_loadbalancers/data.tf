@@ -0,0 +1,10 @@
+data "thalassa_loadbalancers" "public" {
+  vpc_id = thalassa_vpc.example.id
+  label_selector = {
+    exposure = "public"
+  }
+}
+
+output "public_loadbalancer_ips" {
+  value = flatten(data.thalassa_loadbalancers.public.loadbalancers[*].external_ip_addresses)
+}
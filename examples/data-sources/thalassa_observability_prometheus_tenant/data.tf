@@ -0,0 +1,7 @@
+data "thalassa_observability_prometheus_tenant" "production" {
+  name = "production"
+}
+
+output "remote_write_url" {
+  value = data.thalassa_observability_prometheus_tenant.production.remote_write_url
+}
@@ -0,0 +1,7 @@
+data "thalassa_organisation_quota" "vcpus" {
+  name = "vcpus"
+}
+
+output "vcpus_remaining" {
+  value = data.thalassa_organisation_quota.vcpus.remaining
+}
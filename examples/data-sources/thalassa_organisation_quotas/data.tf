@@ -0,0 +1,5 @@
+data "thalassa_organisation_quotas" "all" {}
+
+output "quota_remaining" {
+  value = { for q in data.thalassa_organisation_quotas.all.quotas : q.name => q.remaining }
+}
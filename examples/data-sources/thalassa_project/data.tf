@@ -0,0 +1,7 @@
+data "thalassa_project" "payments" {
+  slug = "payments"
+}
+
+output "payments_project_id" {
+  value = data.thalassa_project.payments.id
+}
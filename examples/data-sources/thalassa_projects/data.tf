@@ -0,0 +1,9 @@
+data "thalassa_projects" "payments_team" {
+  label_selector = {
+    team = "payments"
+  }
+}
+
+output "payments_project_ids" {
+  value = data.thalassa_projects.payments_team.projects[*].id
+}
@@ -0,0 +1,10 @@
+data "thalassa_reserved_ips" "ingress" {
+  region = "nl-01"
+  label_selector = {
+    purpose = "ingress"
+  }
+}
+
+output "ingress_ipv4_addresses" {
+  value = data.thalassa_reserved_ips.ingress.reserved_ips[*].ipv4_address
+}
@@ -0,0 +1,10 @@
+data "thalassa_security_groups" "shared" {
+  vpc_id = thalassa_vpc.example.id
+  label_selector = {
+    shared = "true"
+  }
+}
+
+output "shared_security_group_ids" {
+  value = data.thalassa_security_groups.shared.security_groups[*].id
+}
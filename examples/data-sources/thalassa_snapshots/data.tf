@@ -0,0 +1,9 @@
+data "thalassa_snapshots" "database" {
+  label_selector = {
+    app = "database"
+  }
+}
+
+output "database_snapshots" {
+  value = { for snapshot in data.thalassa_snapshots.database.snapshots : snapshot.name => snapshot.source_volume_id }
+}
@@ -0,0 +1,10 @@
+data "thalassa_subnets" "private" {
+  vpc_id = thalassa_vpc.example.id
+  label_selector = {
+    tier = "private"
+  }
+}
+
+output "private_subnet_cidrs" {
+  value = data.thalassa_subnets.private.subnets[*].cidr
+}
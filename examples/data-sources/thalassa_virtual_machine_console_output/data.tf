@@ -0,0 +1,10 @@
+data "thalassa_virtual_machine_console_output" "example" {
+  machine_id       = thalassa_virtual_machine_instance.example.id
+  max_bytes        = 32768
+  capture_duration = "10s"
+}
+
+# Show the serial console output, e.g. to debug cloud-init
+output "console_output" {
+  value = data.thalassa_virtual_machine_console_output.example.output
+}
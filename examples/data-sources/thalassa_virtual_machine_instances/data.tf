@@ -0,0 +1,14 @@
+data "thalassa_virtual_machine_instances" "web" {
+  vpc_id = thalassa_vpc.example.id
+  label_selector = {
+    tier = "web"
+  }
+}
+
+# Attach every web server to the target group of the load balancer
+resource "thalassa_target_group_attachment" "web" {
+  for_each = { for vmi in data.thalassa_virtual_machine_instances.web.virtual_machine_instances : vmi.name => vmi.id }
+
+  target_group_id = thalassa_target_group.example.id
+  vmi_id          = each.value
+}
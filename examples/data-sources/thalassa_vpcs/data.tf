@@ -0,0 +1,10 @@
+data "thalassa_vpcs" "production" {
+  region = "nl-01"
+  label_selector = {
+    environment = "production"
+  }
+}
+
+output "production_vpc_ids" {
+  value = data.thalassa_vpcs.production.vpcs[*].id
+}
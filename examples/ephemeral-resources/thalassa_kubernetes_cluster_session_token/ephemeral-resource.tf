@@ -0,0 +1,20 @@
+data "thalassa_kubernetes_cluster" "cluster" {
+  name = "example"
+}
+
+# Requires Terraform 1.10 or later. The token is requested on every run and never stored.
+ephemeral "thalassa_kubernetes_cluster_session_token" "cluster" {
+  cluster_id = data.thalassa_kubernetes_cluster.cluster.id
+}
+
+provider "kubernetes" {
+  host                   = ephemeral.thalassa_kubernetes_cluster_session_token.cluster.api_server_url
+  cluster_ca_certificate = base64decode(ephemeral.thalassa_kubernetes_cluster_session_token.cluster.ca_certificate)
+  token                  = ephemeral.thalassa_kubernetes_cluster_session_token.cluster.token
+}
+
+resource "kubernetes_namespace" "example" {
+  metadata {
+    name = "example"
+  }
+}
@@ -0,0 +1,25 @@
+data "thalassa_kubernetes_cluster" "cluster" {
+  name = "example"
+}
+
+# Requires Terraform 1.10 or later. The kubeconfig is requested on every run and never stored.
+ephemeral "thalassa_kubernetes_kubeconfig" "cluster" {
+  cluster_id       = data.thalassa_kubernetes_cluster.cluster.id
+  session_lifetime = "1h"
+}
+
+provider "helm" {
+  kubernetes = {
+    host                   = ephemeral.thalassa_kubernetes_kubeconfig.cluster.api_server_url
+    cluster_ca_certificate = base64decode(ephemeral.thalassa_kubernetes_kubeconfig.cluster.ca_certificate)
+    token                  = ephemeral.thalassa_kubernetes_kubeconfig.cluster.token
+  }
+}
+
+resource "helm_release" "ingress" {
+  name             = "ingress-nginx"
+  repository       = "https://kubernetes.github.io/ingress-nginx"
+  chart            = "ingress-nginx"
+  namespace        = "ingress-nginx"
+  create_namespace = true
+}
@@ -0,0 +1,17 @@
+# Requires Terraform 1.10 or later. The value is read on every run and never stored.
+ephemeral "thalassa_secret_value" "db_password" {
+  region = "nl-01"
+  path   = "/app/prod/db/password"
+}
+
+ephemeral "thalassa_secret_value" "api_credentials" {
+  region  = "nl-01"
+  path    = "/app/prod/api"
+  version = 3
+}
+
+provider "postgresql" {
+  host     = "db.example.internal"
+  username = "app"
+  password = ephemeral.thalassa_secret_value.db_password.secret_string
+}
@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    thalassa = {
+      source = "local/thalassa/thalassa"
+    }
+  }
+}
+
+variable "organisation_id" {
+  type = string
+}
+
+variable "service_account_id" {
+  type = string
+}
+
+resource "thalassa_objectstorage_bucket" "example" {
+  name   = "example-bucket"
+  region = "nl-01"
+  public = false
+
+  policy = provider::thalassa::bucket_policy_document([
+    {
+      effect     = "Allow"
+      principals = [provider::thalassa::principal_arn("serviceaccount", var.organisation_id, var.service_account_id)]
+      actions    = ["s3:GetObject", "s3:PutObject"]
+      resources  = ["arn:thalassa:s3:::example-bucket/*"]
+    },
+  ])
+}
@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    thalassa = {
+      source = "local/thalassa/thalassa"
+    }
+  }
+}
+
+locals {
+  principal = provider::thalassa::parse_principal_arn("arn:thalassa:iam:::serviceaccount/o-org123:sa-account456")
+}
+
+output "principal_kind" {
+  value = local.principal.kind # serviceaccount
+}
+
+output "principal_organisation_id" {
+  value = local.principal.organisation_id # o-org123
+}
+
+output "principal_id" {
+  value = local.principal.id # sa-account456
+}
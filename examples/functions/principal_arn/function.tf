@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    thalassa = {
+      source = "local/thalassa/thalassa"
+    }
+  }
+}
+
+# Principal of a single service account
+output "service_account_principal" {
+  value = provider::thalassa::principal_arn("serviceaccount", "o-org123", "sa-account456")
+  # arn:thalassa:iam:::serviceaccount/o-org123:sa-account456
+}
+
+# Principal of all users of an organisation
+output "all_users_principal" {
+  value = provider::thalassa::principal_arn("user", "o-org123", "*")
+  # arn:thalassa:iam:::user/o-org123:*
+}
@@ -0,0 +1,28 @@
+terraform {
+  required_providers {
+    thalassa = {
+      source = "local/thalassa/thalassa"
+    }
+  }
+}
+
+locals {
+  vpc_cidr = "10.0.0.0/16"
+
+  # 10.0.0.0/24, 10.0.1.0/24 and 10.0.2.0/24
+  subnet_cidrs = provider::thalassa::subnet_cidrs(local.vpc_cidr, 3, 8)
+}
+
+resource "thalassa_vpc" "example" {
+  name   = "example-vpc"
+  region = "nl-01"
+  cidrs  = [local.vpc_cidr]
+}
+
+resource "thalassa_subnet" "example" {
+  count = length(local.subnet_cidrs)
+
+  name   = "example-subnet-${count.index}"
+  vpc_id = thalassa_vpc.example.id
+  cidr   = local.subnet_cidrs[count.index]
+}
@@ -0,0 +1,11 @@
+# Find all production block volumes in the nl-01 region
+list "thalassa_block_volume" "production" {
+  provider = thalassa
+
+  config {
+    region = "nl-01"
+    labels = {
+      environment = "production"
+    }
+  }
+}
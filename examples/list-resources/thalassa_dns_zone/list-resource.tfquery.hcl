@@ -0,0 +1,10 @@
+# Find all DNS zones labelled for production
+list "thalassa_dns_zone" "production" {
+  provider = thalassa
+
+  config {
+    labels = {
+      environment = "production"
+    }
+  }
+}
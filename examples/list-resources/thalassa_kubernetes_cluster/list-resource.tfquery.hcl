@@ -0,0 +1,11 @@
+# Find all production Kubernetes clusters in the nl-01 region
+list "thalassa_kubernetes_cluster" "production" {
+  provider = thalassa
+
+  config {
+    region = "nl-01"
+    labels = {
+      environment = "production"
+    }
+  }
+}
@@ -0,0 +1,11 @@
+# Find all production load balancers in the nl-01 region
+list "thalassa_loadbalancer" "production" {
+  provider = thalassa
+
+  config {
+    region = "nl-01"
+    labels = {
+      environment = "production"
+    }
+  }
+}
@@ -0,0 +1,11 @@
+# Find all production NAT gateways in the nl-01 region
+list "thalassa_natgateway" "production" {
+  provider = thalassa
+
+  config {
+    region = "nl-01"
+    labels = {
+      environment = "production"
+    }
+  }
+}
@@ -0,0 +1,11 @@
+# Find all production route tables in the nl-01 region
+list "thalassa_route_table" "production" {
+  provider = thalassa
+
+  config {
+    region = "nl-01"
+    labels = {
+      environment = "production"
+    }
+  }
+}
@@ -0,0 +1,11 @@
+# Find all production security groups in the nl-01 region
+list "thalassa_security_group" "production" {
+  provider = thalassa
+
+  config {
+    region = "nl-01"
+    labels = {
+      environment = "production"
+    }
+  }
+}
@@ -0,0 +1,11 @@
+# Find all production subnets in the nl-01 region
+list "thalassa_subnet" "production" {
+  provider = thalassa
+
+  config {
+    region = "nl-01"
+    labels = {
+      environment = "production"
+    }
+  }
+}
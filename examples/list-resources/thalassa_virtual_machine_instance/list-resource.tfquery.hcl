@@ -0,0 +1,11 @@
+# Find all production virtual machine instances in the nl-01 region
+list "thalassa_virtual_machine_instance" "production" {
+  provider = thalassa
+
+  config {
+    region = "nl-01"
+    labels = {
+      environment = "production"
+    }
+  }
+}
@@ -0,0 +1,18 @@
+# Find all production VPCs in the nl-01 region.
+# Run `terraform query -generate-config-out=generated.tf` to generate import blocks and configuration for them.
+list "thalassa_vpc" "production" {
+  provider = thalassa
+
+  config {
+    region = "nl-01"
+    labels = {
+      environment = "production"
+    }
+  }
+}
+
+# Include the full resource state, for example to inspect the CIDRs of each VPC
+list "thalassa_vpc" "all" {
+  provider         = thalassa
+  include_resource = true
+}
@@ -0,0 +1,9 @@
+resource "thalassa_container_registry_namespace" "platform" {
+  region      = "nl-01"
+  namespace   = "platform"
+  description = "Images built by the platform team"
+
+  labels = {
+    team = "platform"
+  }
+}
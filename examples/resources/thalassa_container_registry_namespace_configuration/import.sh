@@ -0,0 +1,3 @@
+#!/bin/bash
+# Example: terraform import thalassa_container_registry_namespace_configuration.platform crn-abc123
+terraform import thalassa_container_registry_namespace_configuration.platform crn-abc123
@@ -0,0 +1,26 @@
+resource "thalassa_container_registry_namespace" "platform" {
+  region    = "nl-01"
+  namespace = "platform"
+}
+
+resource "thalassa_container_registry_namespace_configuration" "platform" {
+  namespace_id = thalassa_container_registry_namespace.platform.id
+  visibility   = "private"
+
+  retention_policy {
+    enabled                = true
+    delete_untagged_images = true
+
+    # Keep the 20 most recent release tags of every repository
+    rule {
+      count        = 20
+      tag_patterns = ["v*"]
+    }
+
+    # Remove feature branch builds that have not been pulled for two weeks
+    rule {
+      days_since_pulled = 14
+      tag_patterns      = ["feature-*", "pr-*"]
+    }
+  }
+}
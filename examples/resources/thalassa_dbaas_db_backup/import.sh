@@ -0,0 +1,3 @@
+#!/bin/bash
+# Example: terraform import thalassa_dbaas_db_backup.pre_upgrade dbb-abc123
+terraform import thalassa_dbaas_db_backup.pre_upgrade dbb-abc123
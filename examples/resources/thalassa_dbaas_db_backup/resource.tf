@@ -0,0 +1,43 @@
+variable "engine_version" {
+  type    = string
+  default = "16.9"
+}
+
+# Look up the cluster by name so the backup does not depend on the cluster resource,
+# which would create a cycle with the depends_on below.
+data "thalassa_dbaas_db_cluster" "orders" {
+  name = "orders"
+}
+
+# Take a new backup whenever the target engine version changes.
+resource "thalassa_dbaas_db_backup" "pre_upgrade" {
+  db_cluster_id    = data.thalassa_dbaas_db_cluster.orders.id
+  name             = "orders-pre-upgrade-${var.engine_version}"
+  description      = "Backup taken before upgrading to PostgreSQL ${var.engine_version}"
+  retention_policy = "90d"
+
+  # Keep the backup when it is replaced by the next upgrade.
+  retain_on_destroy = true
+
+  triggers = {
+    engine_version = var.engine_version
+  }
+
+  labels = {
+    purpose = "pre-upgrade"
+  }
+}
+
+resource "thalassa_dbaas_db_cluster" "orders" {
+  name                      = "orders"
+  subnet_id                 = thalassa_subnet.example.id
+  database_instance_type    = "db-pgp-small"
+  engine                    = "postgres"
+  engine_version            = var.engine_version
+  allocated_storage         = 100
+  volume_type_class         = "block"
+  provision_db_object_store = true
+
+  # The upgrade only starts after the backup has completed.
+  depends_on = [thalassa_dbaas_db_backup.pre_upgrade]
+}
@@ -0,0 +1,3 @@
+#!/bin/bash
+# Example: terraform import thalassa_dbaas_db_object_store.backups dbos-abc123
+terraform import thalassa_dbaas_db_object_store.backups dbos-abc123
@@ -0,0 +1,36 @@
+# An object store shared by several database clusters.
+resource "thalassa_dbaas_db_object_store" "backups" {
+  name             = "shared-db-backups"
+  description      = "Backups of the production database clusters"
+  region           = "nl-01"
+  retention_policy = "30d"
+
+  # Set to false and apply before destroying the object store.
+  delete_protection = true
+
+  labels = {
+    environment = "production"
+  }
+}
+
+resource "thalassa_dbaas_db_cluster" "orders" {
+  name                   = "orders"
+  subnet_id              = thalassa_subnet.example.id
+  database_instance_type = "db-pgp-small"
+  engine                 = "postgres"
+  engine_version         = "15.13"
+  allocated_storage      = 100
+  volume_type_class      = "block"
+  db_object_store_id     = thalassa_dbaas_db_object_store.backups.id
+}
+
+resource "thalassa_dbaas_db_cluster" "payments" {
+  name                   = "payments"
+  subnet_id              = thalassa_subnet.example.id
+  database_instance_type = "db-pgp-small"
+  engine                 = "postgres"
+  engine_version         = "15.13"
+  allocated_storage      = 100
+  volume_type_class      = "block"
+  db_object_store_id     = thalassa_dbaas_db_object_store.backups.id
+}
@@ -45,6 +45,21 @@ resource "thalassa_dbaas_pg_roles" "example" {
   password      = "secure_password_123" # Replace with secure password
 }
 
+# Password of the application role, passed in at apply time and never stored in the state
+variable "app_role_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+# Create a PostgreSQL role with a write-only password (Terraform >= 1.11)
+resource "thalassa_dbaas_pg_roles" "app" {
+  db_cluster_id       = thalassa_dbaas_db_cluster.example.id
+  name                = "app_role"
+  password_wo         = var.app_role_password
+  password_wo_version = 1 # Increment to apply a new password
+}
+
 # Output the PostgreSQL roles details
 output "pg_roles_id" {
   value = thalassa_dbaas_pg_roles.example.id
@@ -0,0 +1,3 @@
+#!/bin/bash
+# Example: terraform import thalassa_iam_federated_identity.deploy_main fid-abc123
+terraform import thalassa_iam_federated_identity.deploy_main fid-abc123
@@ -0,0 +1,23 @@
+resource "thalassa_iam_service_account" "deploy" {
+  name        = "github-deploy"
+  description = "Used by GitHub Actions to deploy to production"
+}
+
+resource "thalassa_iam_federated_identity_provider" "github" {
+  name   = "github-actions"
+  issuer = "https://token.actions.githubusercontent.com"
+}
+
+# Allow workflows on the main branch of my-org/my-app to act as the service account
+resource "thalassa_iam_federated_identity" "deploy_main" {
+  name               = "my-app-main"
+  service_account_id = thalassa_iam_service_account.deploy.id
+  provider_id        = thalassa_iam_federated_identity_provider.github.id
+  subject            = "repo:my-org/my-app:ref:refs/heads/main"
+  trusted_audiences  = ["https://api.thalassa.cloud"]
+  allowed_scopes     = ["api:read", "api:write"]
+
+  conditions = jsonencode({
+    ref_protected = true
+  })
+}
@@ -0,0 +1,3 @@
+#!/bin/bash
+# Example: terraform import thalassa_iam_federated_identity_provider.github fip-abc123
+terraform import thalassa_iam_federated_identity_provider.github fip-abc123
@@ -0,0 +1,13 @@
+# Trust GitHub Actions OIDC tokens
+resource "thalassa_iam_federated_identity_provider" "github" {
+  name        = "github-actions"
+  description = "GitHub Actions OIDC issuer"
+  issuer      = "https://token.actions.githubusercontent.com"
+}
+
+# Trust GitLab CI OIDC tokens
+resource "thalassa_iam_federated_identity_provider" "gitlab" {
+  name        = "gitlab"
+  description = "GitLab CI OIDC issuer"
+  issuer      = "https://gitlab.com"
+}
@@ -0,0 +1,3 @@
+#!/bin/bash
+# Example: terraform import thalassa_observability_prometheus_tenant.production prom-abc123
+terraform import thalassa_observability_prometheus_tenant.production prom-abc123
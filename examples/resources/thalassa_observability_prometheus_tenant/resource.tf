@@ -0,0 +1,14 @@
+resource "thalassa_observability_prometheus_tenant" "production" {
+  name        = "production"
+  description = "Metrics for the production Kubernetes clusters"
+  region      = "nl-01"
+  retention   = "90d"
+}
+
+output "remote_write_url" {
+  value = thalassa_observability_prometheus_tenant.production.remote_write_url
+}
+
+output "query_url" {
+  value = thalassa_observability_prometheus_tenant.production.query_url
+}
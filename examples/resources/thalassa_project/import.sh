@@ -0,0 +1,3 @@
+#!/bin/bash
+# Example: terraform import thalassa_project.payments prj-abc123
+terraform import thalassa_project.payments prj-abc123
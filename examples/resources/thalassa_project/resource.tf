@@ -0,0 +1,34 @@
+resource "thalassa_project" "payments" {
+  name        = "payments"
+  description = "Payments platform"
+  labels = {
+    team = "payments"
+  }
+}
+
+resource "thalassa_project" "payments_staging" {
+  name              = "payments-staging"
+  description       = "Staging environment for the payments platform"
+  parent_project_id = thalassa_project.payments.id
+}
+
+# A provider alias scoped to the new project.
+provider "thalassa" {
+  alias      = "payments"
+  project_id = thalassa_project.payments.id
+}
+
+resource "thalassa_vpc" "payments" {
+  provider = thalassa.payments
+  name     = "payments"
+  region   = "nl-01"
+  cidrs    = ["10.10.0.0/16"]
+}
+
+# Alternatively, override the project on a single resource.
+resource "thalassa_vpc" "payments_staging" {
+  project_id = thalassa_project.payments_staging.id
+  name       = "payments-staging"
+  region     = "nl-01"
+  cidrs      = ["10.20.0.0/16"]
+}
@@ -0,0 +1,3 @@
+#!/bin/bash
+# Example: terraform import thalassa_quicklaunch.network ql-abc123
+terraform import thalassa_quicklaunch.network ql-abc123
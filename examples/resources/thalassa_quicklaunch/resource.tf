@@ -0,0 +1,31 @@
+# A VPC with default subnets and a NAT gateway.
+resource "thalassa_quicklaunch" "network" {
+  name   = "sandbox"
+  region = "nl-01"
+}
+
+# A VPC with a Kubernetes cluster and node pool.
+resource "thalassa_quicklaunch" "kubernetes" {
+  template     = "kubernetes"
+  name         = "demo"
+  description  = "Demo Kubernetes environment"
+  region       = "nl-01"
+  vpc_cidr     = "10.40.0.0/16"
+  subnet_cidrs = ["10.40.0.0/20"]
+  machine_type = "pgp-medium"
+
+  # Keep the launched resources when the quick launch is removed.
+  cascade_delete = false
+
+  labels = {
+    environment = "demo"
+  }
+
+  timeouts {
+    create = "45m"
+  }
+}
+
+output "kubernetes_cluster_id" {
+  value = thalassa_quicklaunch.kubernetes.kubernetes_cluster_id
+}
@@ -3,3 +3,17 @@ resource "thalassa_secret_version" "db_password" {
   path          = thalassa_secret.db_password.path
   secret_string = "initial-password"
 }
+
+# Store a secret version without the value ending up in the plan or state (Terraform >= 1.11)
+variable "api_credentials" {
+  type      = map(string)
+  sensitive = true
+  ephemeral = true
+}
+
+resource "thalassa_secret_version" "api_credentials" {
+  region               = "nl-01"
+  path                 = thalassa_secret.db_password.path
+  secret_key_values_wo = jsonencode(var.api_credentials)
+  secret_wo_version    = 1 # Increment to store a new version
+}
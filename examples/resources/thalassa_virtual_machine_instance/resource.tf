@@ -55,6 +55,39 @@ resource "thalassa_virtual_machine_instance" "example" {
   root_volume_size_gb    = 20
   root_volume_type       = data.thalassa_volume_type.block.id
   cloud_init_template_id = thalassa_cloud_init_template.example.id
+
+  # Stop the instance by setting desired_state to "stopped", e.g. outside office hours
+  desired_state = "running"
+  # Restart the instance when the cloud init template changes
+  restart_triggers = {
+    cloud_init = sha256(thalassa_cloud_init_template.example.content)
+  }
+}
+
+# Share one cloud init template between environments and render the differences into it
+resource "thalassa_cloud_init_template" "templated" {
+  name    = "example-templated-cloud-init-template"
+  content = <<-EOT
+    #cloud-config
+    hostname: {{ .hostname }}
+    write_files:
+      - path: /etc/environment-name
+        content: {{ .environment }}
+  EOT
+}
+
+resource "thalassa_virtual_machine_instance" "templated" {
+  name                   = "example-staging-instance"
+  subnet_id              = thalassa_subnet.example.id
+  machine_type           = "pgp-small"
+  machine_image          = data.thalassa_machine_image.ubuntu.name
+  root_volume_size_gb    = 20
+  root_volume_type       = data.thalassa_volume_type.block.id
+  cloud_init_template_id = thalassa_cloud_init_template.templated.id
+  template_variables = {
+    hostname    = "example-staging"
+    environment = "staging"
+  }
 }
 
 # Output the virtual machine instance details
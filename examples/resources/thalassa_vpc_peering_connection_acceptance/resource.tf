@@ -22,9 +22,12 @@ resource "thalassa_vpc_peering_connection" "example" {
 
 # # Example 1: Accept a peering connection by ID
 resource "thalassa_vpc_peering_connection_acceptance" "accept_by_id" {
-  peering_connection_id   = thalassa_vpc_peering_connection.example.id
-  wait_for_active         = true
-  wait_for_active_timeout = 1
+  peering_connection_id = thalassa_vpc_peering_connection.example.id
+  wait_for_active       = true
+
+  timeouts {
+    create = "1m"
+  }
 }
 
 # Configure route tables
@@ -1,10 +1,11 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"log"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 
 	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa"
 )
@@ -14,11 +15,18 @@ func main() {
 	flag.BoolVar(&debug, "debug", false, "Enable debug mode")
 	flag.Parse()
 
-	plugin.Serve(&plugin.ServeOpts{
-		Debug: debug,
-		ProviderFunc: func() *schema.Provider {
-			return thalassa.Provider()
-		},
-		ProviderAddr: "terraform.local/local/thalassa",
-	})
+	ctx := context.Background()
+	providerServer, err := thalassa.ProviderServer(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var serveOpts []tf5server.ServeOpt
+	if debug {
+		serveOpts = append(serveOpts, tf5server.WithManagedDebug())
+	}
+
+	if err := tf5server.Serve("terraform.local/local/thalassa", providerServer, serveOpts...); err != nil {
+		log.Fatal(err)
+	}
 }
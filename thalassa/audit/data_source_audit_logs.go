@@ -0,0 +1,247 @@
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validate "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	tcaudit "github.com/thalassa-cloud/client-go/audit"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+const auditLogsPageSize = 100
+
+func DataSourceAuditLogs() *schema.Resource {
+	return &schema.Resource{
+		Description: "List audit log entries of an organisation. All pages are fetched, so narrow the query with filters for large organisations.",
+		ReadContext: dataSourceAuditLogsRead,
+		Schema: map[string]*schema.Schema{
+			"organisation_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Organisation ID. Defaults to the provider organisation.",
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.IsRFC3339Time,
+				Description:  "Only return entries created at or after this time (RFC3339).",
+			},
+			"end_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.IsRFC3339Time,
+				Description:  "Only return entries created before this time (RFC3339).",
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return entries for actions performed by this user.",
+			},
+			"service_account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return entries for actions performed by this service account.",
+			},
+			"impersonator_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return entries for actions performed while impersonated by this user.",
+			},
+			"resource_types": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Only return entries for these resource types.",
+			},
+			"resource_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return entries for the resource with this identity.",
+			},
+			"actions": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Only return entries for these actions.",
+			},
+			"search_text": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Free text search across the entries.",
+			},
+			"response_status": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validate.IntBetween(100, 599),
+				Description:  "Only return entries whose request completed with this HTTP status code.",
+			},
+			"include_system_services": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Include actions performed by platform system services.",
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1000,
+				ValidateFunc: validate.IntAtLeast(0),
+				Description:  "Maximum number of entries to return. Set to 0 to return all matching entries. Defaults to 1000.",
+			},
+			"logs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Matching audit log entries, in the order returned by the API.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Unique identifier of the event.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time of the event (RFC3339).",
+						},
+						"action": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Action that was performed.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the event.",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the affected resource.",
+						},
+						"resource_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Identity of the affected resource.",
+						},
+						"user_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Identity of the user that performed the action.",
+						},
+						"user_email": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Email address of the user that performed the action.",
+						},
+						"service_account_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Identity of the service account that performed the action.",
+						},
+						"impersonator_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Identity of the user that impersonated the actor.",
+						},
+						"organisation_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Identity of the organisation.",
+						},
+						"context": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "JSON encoded request context of the event.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAuditLogsRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	window, err := parseTimeWindow(d.Get("start_time").(string), d.Get("end_time").(string))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("invalid time window: %w", err))
+	}
+
+	filter := &tcaudit.AuditLogFilter{
+		SearchText:            d.Get("search_text").(string),
+		ServiceAccount:        d.Get("service_account_id").(string),
+		UserIdentity:          d.Get("user_id").(string),
+		ImpersonatorIdentity:  d.Get("impersonator_id").(string),
+		Actions:               convert.ConvertToStringSlice(d.Get("actions")),
+		ResourceTypes:         convert.ConvertToStringSlice(d.Get("resource_types")),
+		ResourceIdentity:      d.Get("resource_id").(string),
+		IncludeSystemServices: d.Get("include_system_services").(bool),
+		ResponseStatus:        d.Get("response_status").(int),
+	}
+	maxResults := d.Get("max_results").(int)
+
+	logs := make([]map[string]any, 0)
+	for page := 1; ; page++ {
+		result, err := client.Audit().ListAuditLogs(ctx, &tcaudit.ListAuditLogsRequest{
+			Page:   page,
+			Limit:  auditLogsPageSize,
+			Filter: filter,
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("listing audit logs: %w", err))
+		}
+
+		for _, log := range result.Items {
+			if !window.contains(log.CreatedAt) {
+				continue
+			}
+			item, err := flattenAuditLog(log)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("encoding audit log %s: %w", log.EventID, err))
+			}
+			logs = append(logs, item)
+			if maxResults > 0 && len(logs) >= maxResults {
+				break
+			}
+		}
+
+		if (maxResults > 0 && len(logs) >= maxResults) || len(result.Items) == 0 || page >= result.TotalPages {
+			break
+		}
+		tflog.Debug(ctx, "Fetching next page of audit logs", map[string]any{
+			"page":        page + 1,
+			"total_pages": result.TotalPages,
+		})
+	}
+
+	d.SetId(auditLogsQueryID(d))
+	if err := d.Set("logs", logs); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// auditLogsQueryID derives a stable ID from the query arguments.
+func auditLogsQueryID(d *schema.ResourceData) string {
+	parts := []string{}
+	for _, key := range []string{"organisation_id", "start_time", "end_time", "user_id", "service_account_id", "impersonator_id", "resource_types", "resource_id", "actions", "search_text", "response_status", "include_system_services", "max_results"} {
+		parts = append(parts, fmt.Sprintf("%s=%v", key, d.Get(key)))
+	}
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\n")))
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,70 @@
+package audit
+
+import (
+	"encoding/json"
+	"time"
+
+	tcaudit "github.com/thalassa-cloud/client-go/audit"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
+)
+
+// timeWindow limits audit log entries to [start, end). A zero bound is open.
+type timeWindow struct {
+	start time.Time
+	end   time.Time
+}
+
+func (w timeWindow) contains(t time.Time) bool {
+	if !w.start.IsZero() && t.Before(w.start) {
+		return false
+	}
+	if !w.end.IsZero() && !t.Before(w.end) {
+		return false
+	}
+	return true
+}
+
+func parseTimeWindow(start, end string) (timeWindow, error) {
+	var window timeWindow
+	var err error
+	if start != "" {
+		if window.start, err = time.Parse(time.RFC3339, start); err != nil {
+			return window, err
+		}
+	}
+	if end != "" {
+		if window.end, err = time.Parse(time.RFC3339, end); err != nil {
+			return window, err
+		}
+	}
+	return window, nil
+}
+
+func flattenAuditLog(log tcaudit.AuditLog) (map[string]any, error) {
+	item := map[string]any{
+		"event_id":           log.EventID,
+		"created_at":         log.CreatedAt.Format(time.RFC3339),
+		"action":             log.Action,
+		"description":        convert.StringValue(log.Description),
+		"resource_type":      convert.StringValue(log.ResourceType),
+		"resource_id":        convert.StringValue(log.ResourceIdentity),
+		"user_id":            convert.StringValue(log.UserIdentity),
+		"user_email":         "",
+		"service_account_id": convert.StringValue(log.ServiceAccountIdentity),
+		"impersonator_id":    convert.StringValue(log.ImpersonatorIdentity),
+		"organisation_id":    convert.StringValue(log.OrganizationIdentity),
+		"context":            "",
+	}
+	if log.User != nil {
+		item["user_email"] = log.User.Email
+	}
+	if len(log.Context) > 0 {
+		b, err := json.Marshal(log.Context)
+		if err != nil {
+			return nil, err
+		}
+		item["context"] = string(b)
+	}
+	return item, nil
+}
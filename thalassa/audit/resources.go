@@ -0,0 +1,9 @@
+package audit
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+var ResourcesMap = map[string]*schema.Resource{}
+
+var DataSourcesMap = map[string]*schema.Resource{
+	"thalassa_audit_logs": DataSourceAuditLogs(),
+}
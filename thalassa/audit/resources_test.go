@@ -0,0 +1,73 @@
+package audit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	tcaudit "github.com/thalassa-cloud/client-go/audit"
+	"github.com/thalassa-cloud/client-go/pkg/base"
+)
+
+func TestDataSourceAuditLogs(t *testing.T) {
+	schema := DataSourceAuditLogs().Schema
+
+	assert.True(t, schema["start_time"].Optional)
+	assert.True(t, schema["end_time"].Optional)
+	assert.Equal(t, 1000, schema["max_results"].Default)
+	assert.True(t, schema["logs"].Computed)
+}
+
+func TestTimeWindow(t *testing.T) {
+	window, err := parseTimeWindow("2026-01-01T00:00:00Z", "2026-01-02T00:00:00Z")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name string
+		time string
+		want bool
+	}{
+		{"before start", "2025-12-31T23:59:59Z", false},
+		{"at start", "2026-01-01T00:00:00Z", true},
+		{"inside", "2026-01-01T12:00:00Z", true},
+		{"at end", "2026-01-02T00:00:00Z", false},
+		{"other time zone inside", "2026-01-01T03:30:00+02:00", true},
+		{"other time zone before start", "2026-01-01T01:30:00+02:00", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts, err := time.Parse(time.RFC3339, tt.time)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, window.contains(ts))
+		})
+	}
+
+	open, err := parseTimeWindow("", "")
+	assert.NoError(t, err)
+	assert.True(t, open.contains(time.Now()))
+
+	_, err = parseTimeWindow("yesterday", "")
+	assert.Error(t, err)
+}
+
+func TestFlattenAuditLog(t *testing.T) {
+	resourceType := "virtual_machine"
+	resourceID := "vm-123"
+	item, err := flattenAuditLog(tcaudit.AuditLog{
+		EventID:          "evt-1",
+		CreatedAt:        time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+		Action:           "create",
+		ResourceType:     &resourceType,
+		ResourceIdentity: &resourceID,
+		User:             &base.AppUser{Email: "jane@example.com"},
+		Context:          map[string]any{"sourceIp": "192.0.2.1"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "2026-01-01T12:00:00Z", item["created_at"])
+	assert.Equal(t, "virtual_machine", item["resource_type"])
+	assert.Equal(t, "vm-123", item["resource_id"])
+	assert.Equal(t, "jane@example.com", item["user_email"])
+	assert.Equal(t, "", item["service_account_id"])
+	assert.JSONEq(t, `{"sourceIp":"192.0.2.1"}`, item["context"].(string))
+}
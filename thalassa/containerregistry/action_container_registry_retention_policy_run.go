@@ -0,0 +1,74 @@
+package containerregistry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+var _ action.ActionWithConfigure = &retentionPolicyRunAction{}
+
+// NewRetentionPolicyRunAction returns the thalassa_container_registry_retention_policy_run action.
+func NewRetentionPolicyRunAction() action.Action {
+	return &retentionPolicyRunAction{}
+}
+
+type retentionPolicyRunAction struct {
+	provider.ActionBase
+}
+
+type retentionPolicyRunModel struct {
+	OrganisationID types.String `tfsdk:"organisation_id"`
+	ProjectID      types.String `tfsdk:"project_id"`
+	NamespaceID    types.String `tfsdk:"namespace_id"`
+}
+
+func (a *retentionPolicyRunAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_container_registry_retention_policy_run"
+}
+
+func (a *retentionPolicyRunAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Run the retention policy of a container registry namespace now, instead of waiting for its schedule",
+		Attributes: map[string]schema.Attribute{
+			"organisation_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Organisation ID. Defaults to the provider organisation.",
+			},
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Project ID. Defaults to the provider project.",
+			},
+			"namespace_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Identity of the container registry namespace whose retention policy to run.",
+			},
+		},
+	}
+}
+
+func (a *retentionPolicyRunAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data retentionPolicyRunModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := a.Client(data.OrganisationID, data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	namespaceID := data.NamespaceID.ValueString()
+	if err := client.ContainerRegistry().RunRetentionPolicy(ctx, namespaceID); err != nil {
+		resp.Diagnostics.AddError("Failed to run container registry retention policy", err.Error())
+		return
+	}
+	provider.SendActionProgress(resp, fmt.Sprintf("Started the retention policy of container registry namespace %s", namespaceID))
+}
@@ -0,0 +1,143 @@
+package containerregistry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	tccr "github.com/thalassa-cloud/client-go/containerregistry"
+	tcclient "github.com/thalassa-cloud/client-go/pkg/client"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+func DataSourceContainerRegistryNamespace() *schema.Resource {
+	return &schema.Resource{
+		Description: "Look up a container registry namespace by identity or name",
+		ReadContext: dataSourceContainerRegistryNamespaceRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "namespace"},
+				Description:  "Platform identity of the container registry namespace.",
+			},
+			"organisation_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Organisation ID. Defaults to the provider organisation.",
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Project ID. Defaults to the provider project.",
+			},
+			"namespace": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "namespace"},
+				Description:  "Name of the namespace (used when id is not set).",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Region of the namespace. Used to narrow a lookup by name.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Human-readable description.",
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Labels for the namespace.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Annotations for the namespace.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"visibility": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Visibility of the namespace, if configured.",
+			},
+			"total_size_bytes": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total size of all artifacts stored in the namespace, in bytes.",
+			},
+			"object_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Platform object version for optimistic concurrency.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation timestamp (RFC3339).",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last update timestamp (RFC3339).",
+			},
+		},
+	}
+}
+
+func dataSourceContainerRegistryNamespaceRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := d.Get("region").(string)
+	var namespace *tccr.ContainerRegistryNamespace
+
+	if id, ok := d.GetOk("id"); ok && id.(string) != "" {
+		namespace, err = client.ContainerRegistry().GetContainerRegistryNamespace(ctx, id.(string))
+		if err != nil {
+			if tcclient.IsNotFound(err) {
+				return diag.Errorf("container registry namespace %s not found", id.(string))
+			}
+			return diag.FromErr(fmt.Errorf("reading container registry namespace: %w", err))
+		}
+	} else {
+		name := d.Get("namespace").(string)
+		namespaces, err := client.ContainerRegistry().ListContainerRegistryNamespaces(ctx, &tccr.ListContainerRegistryNamespacesRequest{})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("listing container registry namespaces: %w", err))
+		}
+		for i := range namespaces {
+			if namespaces[i].Namespace == name && convert.RegionMatches(namespaces[i].Region, region) {
+				namespace = &namespaces[i]
+				break
+			}
+		}
+	}
+
+	if namespace == nil {
+		return diag.Errorf("container registry namespace not found")
+	}
+
+	d.SetId(namespace.Identity)
+	if err := setContainerRegistryNamespaceState(d, namespace); err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("region", convert.RegionStateValue(namespace.Region, region))
+	if namespace.Configuration != nil {
+		_ = d.Set("visibility", string(namespace.Configuration.Visibility))
+	}
+
+	return nil
+}
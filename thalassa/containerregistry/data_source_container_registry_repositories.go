@@ -0,0 +1,157 @@
+package containerregistry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	tccr "github.com/thalassa-cloud/client-go/containerregistry"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+func DataSourceContainerRegistryRepositories() *schema.Resource {
+	return &schema.Resource{
+		Description: "List the repositories, and optionally their tags, in a container registry namespace",
+		ReadContext: dataSourceContainerRegistryRepositoriesRead,
+		Schema: map[string]*schema.Schema{
+			"organisation_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Organisation ID. Defaults to the provider organisation.",
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Project ID. Defaults to the provider project.",
+			},
+			"namespace_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Identity of the container registry namespace.",
+			},
+			"include_tags": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Fetch the tags of every repository. This performs one additional API request per repository.",
+			},
+			"repositories": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Repositories in the namespace.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Identity of the repository.",
+						},
+						"image": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Image name of the repository.",
+						},
+						"full_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Fully qualified name, including the namespace.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the repository.",
+						},
+						"tag_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of tags in the repository.",
+						},
+						"artifact_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of artifacts in the repository.",
+						},
+						"total_size_bytes": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Total size of the repository, in bytes.",
+						},
+						"last_pushed_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Timestamp of the last push (RFC3339).",
+						},
+						"last_pulled_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Timestamp of the last pull (RFC3339).",
+						},
+						"tags": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Tags of the repository. Only populated when include_tags is true or the API returns them inline.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"tag": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"sha256": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"size_mb": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+									"created_at": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceContainerRegistryRepositoriesRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespaceID := d.Get("namespace_id").(string)
+	repositories, err := client.ContainerRegistry().ListContainerRegistryRepositories(ctx, namespaceID, &tccr.ListContainerRegistryRepositoriesRequest{})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("listing container registry repositories: %w", err))
+	}
+
+	if d.Get("include_tags").(bool) {
+		for i := range repositories {
+			if len(repositories[i].Tags) > 0 {
+				continue
+			}
+			repository, err := client.ContainerRegistry().GetContainerRegistryRepository(ctx, namespaceID, repositories[i].Identity)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("reading container registry repository %s: %w", repositories[i].Identity, err))
+			}
+			if repository != nil {
+				repositories[i].Tags = repository.Tags
+			}
+		}
+	}
+
+	d.SetId(namespaceID)
+	if err := d.Set("repositories", flattenRepositories(repositories)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package containerregistry
+
+import (
+	"regexp"
+	"time"
+
+	tccr "github.com/thalassa-cloud/client-go/containerregistry"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
+)
+
+// namespaceNameRegexp matches OCI distribution namespace path components.
+var namespaceNameRegexp = regexp.MustCompile(`^[a-z0-9]+(?:[._-][a-z0-9]+)*$`)
+
+var namespaceVisibilities = []string{
+	string(tccr.NamespaceVisibilityPrivate),
+	"public",
+}
+
+var retentionPolicyScopes = []string{
+	string(tccr.RetentionPolicyScopeTags),
+}
+
+func setContainerRegistryNamespaceState(d interface {
+	Set(string, any) error
+}, namespace *tccr.ContainerRegistryNamespace) error {
+	_ = d.Set("namespace", namespace.Namespace)
+	_ = d.Set("description", namespace.Description)
+	_ = d.Set("labels", namespace.Labels)
+	_ = d.Set("annotations", namespace.Annotations)
+	_ = d.Set("total_size_bytes", namespace.TotalSizeBytes)
+	_ = d.Set("object_version", namespace.ObjectVersion)
+	if !namespace.CreatedAt.IsZero() {
+		_ = d.Set("created_at", namespace.CreatedAt.Format(time.RFC3339))
+	}
+	if !namespace.UpdatedAt.IsZero() {
+		_ = d.Set("updated_at", namespace.UpdatedAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func expandRetentionPolicy(v []any) *tccr.RetentionPolicy {
+	if len(v) == 0 || v[0] == nil {
+		return nil
+	}
+	raw := v[0].(map[string]any)
+
+	policy := &tccr.RetentionPolicy{
+		Enabled:              raw["enabled"].(bool),
+		DeleteUntaggedImages: raw["delete_untagged_images"].(bool),
+		Rules:                []tccr.RetentionPolicyRule{},
+	}
+
+	for _, r := range raw["rule"].([]any) {
+		if r == nil {
+			continue
+		}
+		rule := r.(map[string]any)
+		policy.Rules = append(policy.Rules, tccr.RetentionPolicyRule{
+			Days:               convert.OptionalInt(rule["days"]),
+			DaysSinceCreated:   convert.OptionalInt(rule["days_since_created"]),
+			DaysSincePulled:    convert.OptionalInt(rule["days_since_pulled"]),
+			Count:              convert.OptionalInt(rule["count"]),
+			RepositoryPatterns: convert.ConvertToStringSlice(rule["repository_patterns"]),
+			TagPatterns:        convert.ConvertToStringSlice(rule["tag_patterns"]),
+			Scope:              tccr.RetentionPolicyScope(rule["scope"].(string)),
+		})
+	}
+
+	return policy
+}
+
+func flattenRetentionPolicy(policy *tccr.RetentionPolicy) []map[string]any {
+	if policy == nil {
+		return []map[string]any{}
+	}
+
+	rules := make([]map[string]any, 0, len(policy.Rules))
+	for _, rule := range policy.Rules {
+		scope := string(rule.Scope)
+		if scope == "" {
+			scope = string(tccr.RetentionPolicyScopeTags)
+		}
+		rules = append(rules, map[string]any{
+			"days":                convert.IntValue(rule.Days),
+			"days_since_created":  convert.IntValue(rule.DaysSinceCreated),
+			"days_since_pulled":   convert.IntValue(rule.DaysSincePulled),
+			"count":               convert.IntValue(rule.Count),
+			"repository_patterns": rule.RepositoryPatterns,
+			"tag_patterns":        rule.TagPatterns,
+			"scope":               scope,
+		})
+	}
+
+	return []map[string]any{
+		{
+			"enabled":                policy.Enabled,
+			"delete_untagged_images": policy.DeleteUntaggedImages,
+			"rule":                   rules,
+		},
+	}
+}
+
+func flattenRepositories(repositories []tccr.ContainerRegistryRepository) []map[string]any {
+	result := make([]map[string]any, 0, len(repositories))
+	for _, repo := range repositories {
+		item := map[string]any{
+			"id":               repo.Identity,
+			"image":            repo.Image,
+			"full_name":        repo.FullName,
+			"description":      repo.Description,
+			"tag_count":        int(repo.TagCount),
+			"artifact_count":   int(repo.ArtifactCount),
+			"total_size_bytes": int(repo.TotalSizeBytes),
+			"last_pushed_at":   convert.FormatOptionalTime(repo.LastPushedAt),
+			"last_pulled_at":   convert.FormatOptionalTime(repo.LastPulledAt),
+		}
+
+		tags := make([]map[string]any, 0, len(repo.Tags))
+		for _, tag := range repo.Tags {
+			tagItem := map[string]any{
+				"tag":     tag.Tag,
+				"sha256":  tag.Sha256,
+				"size_mb": tag.SizeMb,
+			}
+			if !tag.CreatedAt.IsZero() {
+				tagItem["created_at"] = tag.CreatedAt.Format(time.RFC3339)
+			}
+			tags = append(tags, tagItem)
+		}
+		item["tags"] = tags
+
+		result = append(result, item)
+	}
+	return result
+}
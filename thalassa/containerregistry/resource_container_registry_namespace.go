@@ -0,0 +1,209 @@
+package containerregistry
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validate "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	tccr "github.com/thalassa-cloud/client-go/containerregistry"
+	iaas "github.com/thalassa-cloud/client-go/iaas"
+	tcclient "github.com/thalassa-cloud/client-go/pkg/client"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+func ResourceContainerRegistryNamespace() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Create and manage a container registry namespace in Thalassa Cloud",
+		CreateContext: resourceContainerRegistryNamespaceCreate,
+		ReadContext:   resourceContainerRegistryNamespaceRead,
+		UpdateContext: resourceContainerRegistryNamespaceUpdate,
+		DeleteContext: resourceContainerRegistryNamespaceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Platform identity of the container registry namespace.",
+			},
+			"organisation_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Organisation ID. Defaults to the provider organisation.",
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Project ID. Defaults to the provider project.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Region of the namespace (slug or identity, e.g. nl-01).",
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validate.All(
+					validate.StringLenBetween(2, 63),
+					validate.StringMatch(namespaceNameRegexp, "must consist of lowercase alphanumeric characters, optionally separated by '.', '_' or '-'"),
+				),
+				Description: "Name of the namespace. Images are pushed to <registry>/<namespace>/<repository>. Renaming requires recreation.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.StringLenBetween(0, 255),
+				Description:  "Human-readable description.",
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Labels for the namespace.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"annotations": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Annotations for the namespace.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"total_size_bytes": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total size of all artifacts stored in the namespace, in bytes.",
+			},
+			"object_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Platform object version for optimistic concurrency.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation timestamp (RFC3339).",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last update timestamp (RFC3339).",
+			},
+		},
+	}
+}
+
+func resourceContainerRegistryNamespaceCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := d.Get("region").(string)
+	regions, err := client.IaaS().ListRegions(ctx, &iaas.ListRegionsRequest{})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("listing regions: %w", err))
+	}
+	found := false
+	for _, r := range regions {
+		if r.Identity == region || r.Slug == region || r.Name == region {
+			region = r.Identity
+			found = true
+			break
+		}
+	}
+	if !found {
+		available := make([]string, len(regions))
+		for i, r := range regions {
+			available[i] = r.Slug
+		}
+		return diag.Errorf("region not found: %s. Available regions: %s", region, strings.Join(available, ", "))
+	}
+
+	namespace, err := client.ContainerRegistry().CreateContainerRegistryNamespace(ctx, tccr.CreateContainerRegistryNamespaceRequest{
+		Region:      region,
+		Namespace:   d.Get("namespace").(string),
+		Description: d.Get("description").(string),
+		Labels:      convert.ConvertToMap(d.Get("labels")),
+		Annotations: convert.ConvertToMap(d.Get("annotations")),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("creating container registry namespace: %w", err))
+	}
+
+	d.SetId(namespace.Identity)
+	return resourceContainerRegistryNamespaceRead(ctx, d, m)
+}
+
+func resourceContainerRegistryNamespaceRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, err := client.ContainerRegistry().GetContainerRegistryNamespace(ctx, d.Id())
+	if err != nil {
+		if tcclient.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("reading container registry namespace: %w", err))
+	}
+	if namespace == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if err := setContainerRegistryNamespaceState(d, namespace); err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("region", convert.RegionStateValue(namespace.Region, d.Get("region").(string)))
+
+	return nil
+}
+
+func resourceContainerRegistryNamespaceUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.ContainerRegistry().UpdateContainerRegistryNamespace(ctx, d.Id(), tccr.UpdateContainerRegistryNamespaceRequest{
+		Description: d.Get("description").(string),
+		Labels:      convert.ConvertToMap(d.Get("labels")),
+		Annotations: convert.ConvertToMap(d.Get("annotations")),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("updating container registry namespace: %w", err))
+	}
+
+	return resourceContainerRegistryNamespaceRead(ctx, d, m)
+}
+
+func resourceContainerRegistryNamespaceDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.ContainerRegistry().DeleteContainerRegistryNamespace(ctx, d.Id()); err != nil {
+		if tcclient.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("deleting container registry namespace: %w", err))
+	}
+
+	d.SetId("")
+	return nil
+}
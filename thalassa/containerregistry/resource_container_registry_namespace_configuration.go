@@ -0,0 +1,237 @@
+package containerregistry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validate "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	tccr "github.com/thalassa-cloud/client-go/containerregistry"
+	tcclient "github.com/thalassa-cloud/client-go/pkg/client"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+func ResourceContainerRegistryNamespaceConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage the visibility and retention policy of a container registry namespace. The resource ID is the namespace identity.",
+		CreateContext: resourceContainerRegistryNamespaceConfigurationCreate,
+		ReadContext:   resourceContainerRegistryNamespaceConfigurationRead,
+		UpdateContext: resourceContainerRegistryNamespaceConfigurationUpdate,
+		DeleteContext: resourceContainerRegistryNamespaceConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identity of the container registry namespace.",
+			},
+			"organisation_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Organisation ID. Defaults to the provider organisation.",
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Project ID. Defaults to the provider project.",
+			},
+			"namespace_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identity of the container registry namespace to configure.",
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(tccr.NamespaceVisibilityPrivate),
+				ValidateFunc: validate.StringInSlice(namespaceVisibilities, false),
+				Description:  "Visibility of the namespace: private or public.",
+			},
+			"retention_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Retention policy that removes tags and artifacts from repositories in the namespace.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether the retention policy is active.",
+						},
+						"delete_untagged_images": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether untagged images are deleted.",
+						},
+						"rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Retention rules. Resources matching any rule are retained according to that rule's criteria.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"scope": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      string(tccr.RetentionPolicyScopeTags),
+										ValidateFunc: validate.StringInSlice(retentionPolicyScopes, false),
+										Description:  "Resources covered by the rule. Currently only tags is supported.",
+									},
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validate.IntAtLeast(1),
+										Description:  "Delete tags older than this number of days, based on tag creation time.",
+									},
+									"days_since_created": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validate.IntAtLeast(1),
+										Description:  "Keep artifacts pushed within this number of days.",
+									},
+									"days_since_pulled": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validate.IntAtLeast(1),
+										Description:  "Keep artifacts pulled within this number of days.",
+									},
+									"count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validate.IntAtLeast(1),
+										Description:  "Keep only the most recent number of tags.",
+									},
+									"repository_patterns": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: "Repository name patterns the rule applies to, with wildcard support (e.g. myapp/*). Empty matches all repositories.",
+										Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.StringIsNotEmpty},
+									},
+									"tag_patterns": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: "Tag patterns the rule applies to, with wildcard support (e.g. v*). Empty matches all tags.",
+										Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.StringIsNotEmpty},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"object_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Platform object version for optimistic concurrency.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation timestamp (RFC3339).",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last update timestamp (RFC3339).",
+			},
+		},
+	}
+}
+
+func resourceContainerRegistryNamespaceConfigurationCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespaceID := d.Get("namespace_id").(string)
+	_, err = client.ContainerRegistry().CreateNamespaceConfiguration(ctx, namespaceID, tccr.CreateNamespaceConfigurationRequest{
+		Visibility:      tccr.NamespaceVisibility(d.Get("visibility").(string)),
+		RetentionPolicy: expandRetentionPolicy(d.Get("retention_policy").([]any)),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("creating container registry namespace configuration: %w", err))
+	}
+
+	d.SetId(namespaceID)
+	return resourceContainerRegistryNamespaceConfigurationRead(ctx, d, m)
+}
+
+func resourceContainerRegistryNamespaceConfigurationRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	configuration, err := client.ContainerRegistry().GetNamespaceConfiguration(ctx, d.Id())
+	if err != nil {
+		if tcclient.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("reading container registry namespace configuration: %w", err))
+	}
+	if configuration == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("namespace_id", d.Id())
+	_ = d.Set("visibility", string(configuration.Visibility))
+	_ = d.Set("retention_policy", flattenRetentionPolicy(configuration.RetentionPolicy))
+	_ = d.Set("object_version", configuration.ObjectVersion)
+	if !configuration.CreatedAt.IsZero() {
+		_ = d.Set("created_at", configuration.CreatedAt.Format(time.RFC3339))
+	}
+	if !configuration.UpdatedAt.IsZero() {
+		_ = d.Set("updated_at", configuration.UpdatedAt.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceContainerRegistryNamespaceConfigurationUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.ContainerRegistry().UpdateNamespaceConfiguration(ctx, d.Id(), tccr.UpdateNamespaceConfigurationRequest{
+		Visibility:      tccr.NamespaceVisibility(d.Get("visibility").(string)),
+		RetentionPolicy: expandRetentionPolicy(d.Get("retention_policy").([]any)),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("updating container registry namespace configuration: %w", err))
+	}
+
+	return resourceContainerRegistryNamespaceConfigurationRead(ctx, d, m)
+}
+
+func resourceContainerRegistryNamespaceConfigurationDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.ContainerRegistry().DeleteNamespaceConfiguration(ctx, d.Id()); err != nil {
+		if tcclient.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("deleting container registry namespace configuration: %w", err))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,20 @@
+package containerregistry
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var ResourcesMap = map[string]*schema.Resource{
+	"thalassa_container_registry_namespace":               ResourceContainerRegistryNamespace(),
+	"thalassa_container_registry_namespace_configuration": ResourceContainerRegistryNamespaceConfiguration(),
+}
+
+var DataSourcesMap = map[string]*schema.Resource{
+	"thalassa_container_registry_namespace":    DataSourceContainerRegistryNamespace(),
+	"thalassa_container_registry_repositories": DataSourceContainerRegistryRepositories(),
+}
+
+var Actions = []func() action.Action{
+	NewRetentionPolicyRunAction,
+}
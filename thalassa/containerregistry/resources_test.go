@@ -0,0 +1,74 @@
+package containerregistry
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	tccr "github.com/thalassa-cloud/client-go/containerregistry"
+)
+
+func TestResourceContainerRegistryNamespace(t *testing.T) {
+	resource := ResourceContainerRegistryNamespace()
+	schema := resource.Schema
+
+	assert.True(t, schema["region"].Required)
+	assert.True(t, schema["region"].ForceNew)
+	assert.True(t, schema["namespace"].ForceNew)
+	assert.False(t, schema["description"].ForceNew)
+	assert.NotNil(t, resource.Importer)
+}
+
+func TestResourceContainerRegistryNamespaceConfiguration(t *testing.T) {
+	resource := ResourceContainerRegistryNamespaceConfiguration()
+	assert.True(t, resource.Schema["namespace_id"].ForceNew)
+	assert.Equal(t, 1, resource.Schema["retention_policy"].MaxItems)
+	assert.NotNil(t, resource.Importer)
+}
+
+func TestNamespaceNameRegexp(t *testing.T) {
+	for _, valid := range []string{"team-a", "platform", "my.app_v2"} {
+		assert.True(t, namespaceNameRegexp.MatchString(valid), valid)
+	}
+	for _, invalid := range []string{"Team", "-leading", "trailing-", "a--b", "has space"} {
+		assert.False(t, namespaceNameRegexp.MatchString(invalid), invalid)
+	}
+}
+
+func TestExpandFlattenRetentionPolicy(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceContainerRegistryNamespaceConfiguration().Schema, map[string]any{
+		"namespace_id": "crn-abc123",
+		"retention_policy": []any{
+			map[string]any{
+				"enabled":                true,
+				"delete_untagged_images": true,
+				"rule": []any{
+					map[string]any{
+						"count":        10,
+						"tag_patterns": []any{"v*"},
+					},
+				},
+			},
+		},
+	})
+
+	policy := expandRetentionPolicy(d.Get("retention_policy").([]any))
+	assert.NotNil(t, policy)
+	assert.True(t, policy.Enabled)
+	assert.True(t, policy.DeleteUntaggedImages)
+	assert.Len(t, policy.Rules, 1)
+	assert.Equal(t, 10, *policy.Rules[0].Count)
+	assert.Nil(t, policy.Rules[0].Days)
+	assert.Equal(t, []string{"v*"}, policy.Rules[0].TagPatterns)
+	assert.Equal(t, tccr.RetentionPolicyScopeTags, policy.Rules[0].Scope)
+
+	flattened := flattenRetentionPolicy(policy)
+	assert.Len(t, flattened, 1)
+	rules := flattened[0]["rule"].([]map[string]any)
+	assert.Equal(t, 10, rules[0]["count"])
+	assert.Equal(t, 0, rules[0]["days"])
+
+	assert.Nil(t, expandRetentionPolicy(nil))
+	assert.Empty(t, flattenRetentionPolicy(nil))
+}
@@ -1,5 +1,7 @@
 package convert
 
+import "time"
+
 func Ptr[T any](v T) *T {
 	return &v
 }
@@ -11,6 +13,31 @@ func StringValue(v *string) string {
 	return *v
 }
 
+// IntValue returns the value of an optional integer, or zero when it is unset.
+func IntValue(v *int) int {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+// OptionalInt returns a pointer to a positive schema integer, or nil when the value is unset or zero.
+func OptionalInt(v any) *int {
+	i, ok := v.(int)
+	if !ok || i <= 0 {
+		return nil
+	}
+	return &i
+}
+
+// FormatOptionalTime formats an optional timestamp as RFC 3339, or returns an empty string when it is unset.
+func FormatOptionalTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
 func ConvertToStringSlice(v any) []string {
 	if v == nil {
 		return []string{}
@@ -2,6 +2,7 @@ package convert_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -35,3 +36,22 @@ func TestStringValue(t *testing.T) {
 		})
 	}
 }
+
+func TestOptionalInt(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, convert.OptionalInt(nil))
+	assert.Nil(t, convert.OptionalInt(0))
+	assert.Equal(t, convert.Ptr(7), convert.OptionalInt(7))
+	assert.Equal(t, 7, convert.IntValue(convert.OptionalInt(7)))
+	assert.Equal(t, 0, convert.IntValue(nil))
+}
+
+func TestFormatOptionalTime(t *testing.T) {
+	t.Parallel()
+
+	timestamp := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+	assert.Equal(t, "2024-05-01T12:30:00Z", convert.FormatOptionalTime(&timestamp))
+	assert.Equal(t, "", convert.FormatOptionalTime(&time.Time{}))
+	assert.Equal(t, "", convert.FormatOptionalTime(nil))
+}
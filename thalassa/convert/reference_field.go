@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	iaas "github.com/thalassa-cloud/client-go/iaas"
 )
 
 // SetReferenceField keeps the user's reference (identity, slug, or name) when it still matches
@@ -30,3 +31,29 @@ func SetReferenceField(d *schema.ResourceData, field, identity, slug, name strin
 		_ = d.Set(field, identity)
 	}
 }
+
+// RegionStateValue keeps the region in the form the user configured (slug or identity). Without a configured region
+// it returns the slug of the region, falling back to its identity.
+func RegionStateValue(region *iaas.Region, current string) string {
+	if region == nil {
+		return current
+	}
+	if current == region.Identity {
+		return region.Identity
+	}
+	if region.Slug != "" {
+		return region.Slug
+	}
+	return region.Identity
+}
+
+// RegionMatches reports whether the region matches the filter by identity, slug or name. An empty filter matches any region.
+func RegionMatches(region *iaas.Region, filter string) bool {
+	if filter == "" {
+		return true
+	}
+	if region == nil {
+		return false
+	}
+	return region.Identity == filter || region.Slug == filter || region.Name == filter
+}
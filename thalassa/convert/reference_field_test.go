@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
+	iaas "github.com/thalassa-cloud/client-go/iaas"
 
 	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
 )
@@ -93,3 +94,27 @@ func TestSetReferenceField(t *testing.T) {
 		})
 	}
 }
+
+func TestRegionStateValue(t *testing.T) {
+	t.Parallel()
+
+	region := &iaas.Region{Identity: "region-123", Slug: "nl-01"}
+	assert.Equal(t, "nl-01", convert.RegionStateValue(region, ""))
+	assert.Equal(t, "nl-01", convert.RegionStateValue(region, "nl-01"))
+	assert.Equal(t, "region-123", convert.RegionStateValue(region, "region-123"))
+	assert.Equal(t, "region-123", convert.RegionStateValue(&iaas.Region{Identity: "region-123"}, ""))
+	assert.Equal(t, "nl-01", convert.RegionStateValue(nil, "nl-01"))
+	assert.Equal(t, "", convert.RegionStateValue(nil, ""))
+}
+
+func TestRegionMatches(t *testing.T) {
+	t.Parallel()
+
+	region := &iaas.Region{Identity: "region-123", Slug: "nl-01", Name: "Netherlands 01"}
+	assert.True(t, convert.RegionMatches(region, ""))
+	assert.True(t, convert.RegionMatches(region, "region-123"))
+	assert.True(t, convert.RegionMatches(region, "nl-01"))
+	assert.True(t, convert.RegionMatches(region, "Netherlands 01"))
+	assert.False(t, convert.RegionMatches(region, "de-01"))
+	assert.False(t, convert.RegionMatches(nil, "nl-01"))
+}
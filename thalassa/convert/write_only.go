@@ -0,0 +1,24 @@
+package convert
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// RawConfigGetter is implemented by *schema.ResourceData.
+type RawConfigGetter interface {
+	GetRawConfigAt(valPath cty.Path) (cty.Value, diag.Diagnostics)
+}
+
+// WriteOnlyString returns the configured value of a write-only string attribute. Write-only values are never
+// stored in the plan or state, so they can only be read from the raw configuration during apply.
+func WriteOnlyString(d RawConfigGetter, key string) (string, diag.Diagnostics) {
+	value, diags := d.GetRawConfigAt(cty.GetAttrPath(key))
+	if diags.HasError() {
+		return "", diags
+	}
+	if !value.IsKnown() || value.IsNull() || !value.Type().Equals(cty.String) {
+		return "", nil
+	}
+	return value.AsString(), nil
+}
@@ -0,0 +1,46 @@
+package convert_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
+)
+
+type rawConfig cty.Value
+
+func (c rawConfig) GetRawConfigAt(valPath cty.Path) (cty.Value, diag.Diagnostics) {
+	value, err := valPath.Apply(cty.Value(c))
+	if err != nil {
+		return cty.DynamicVal, diag.FromErr(err)
+	}
+	return value, nil
+}
+
+func TestWriteOnlyString(t *testing.T) {
+	t.Parallel()
+
+	config := rawConfig(cty.ObjectVal(map[string]cty.Value{
+		"password_wo": cty.StringVal("s3cret"),
+		"unset_wo":    cty.NullVal(cty.String),
+		"unknown_wo":  cty.UnknownVal(cty.String),
+	}))
+
+	value, diags := convert.WriteOnlyString(config, "password_wo")
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "s3cret", value)
+
+	value, diags = convert.WriteOnlyString(config, "unset_wo")
+	assert.False(t, diags.HasError())
+	assert.Empty(t, value)
+
+	value, diags = convert.WriteOnlyString(config, "unknown_wo")
+	assert.False(t, diags.HasError())
+	assert.Empty(t, value)
+
+	_, diags = convert.WriteOnlyString(config, "missing_wo")
+	assert.True(t, diags.HasError())
+}
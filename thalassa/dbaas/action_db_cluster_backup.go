@@ -0,0 +1,164 @@
+package dbaas
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/thalassa-cloud/client-go/dbaas"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+const dbClusterBackupActionTimeout = 60 * time.Minute
+
+var (
+	_ action.ActionWithConfigure      = &dbClusterBackupAction{}
+	_ action.ActionWithValidateConfig = &dbClusterBackupAction{}
+)
+
+// NewDbClusterBackupAction returns the thalassa_dbaas_db_cluster_backup action.
+func NewDbClusterBackupAction() action.Action {
+	return &dbClusterBackupAction{}
+}
+
+type dbClusterBackupAction struct {
+	provider.ActionBase
+}
+
+type dbClusterBackupModel struct {
+	OrganisationID    types.String `tfsdk:"organisation_id"`
+	ProjectID         types.String `tfsdk:"project_id"`
+	DbClusterID       types.String `tfsdk:"db_cluster_id"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	RetentionPolicy   types.String `tfsdk:"retention_policy"`
+	WaitUntilComplete types.Bool   `tfsdk:"wait_until_complete"`
+}
+
+func (a *dbClusterBackupAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dbaas_db_cluster_backup"
+}
+
+func (a *dbClusterBackupAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Take an on-demand backup of a database cluster. Unlike thalassa_dbaas_db_backup, the backup is not managed by Terraform and is kept according to its retention policy.",
+		Attributes: map[string]schema.Attribute{
+			"organisation_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Reference to the Organisation of the backup. If not provided, the organisation of the (Terraform) provider will be used.",
+			},
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
+			"db_cluster_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the database cluster to back up",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the backup. Defaults to a name with the time of the backup.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "The description of the backup",
+			},
+			"retention_policy": schema.StringAttribute{
+				Optional:    true,
+				Description: "How long the platform keeps the backup, in the format <number>d (e.g. 30d). Uses the retention policy of the object store when not set.",
+			},
+			"wait_until_complete": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Wait until the backup has completed. Defaults to true.",
+			},
+		},
+	}
+}
+
+func (a *dbClusterBackupAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var data dbClusterBackupModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.RetentionPolicy.IsNull() || data.RetentionPolicy.IsUnknown() {
+		return
+	}
+	_, errs := validateRetentionPolicy(data.RetentionPolicy.ValueString(), "retention_policy")
+	for _, err := range errs {
+		resp.Diagnostics.AddAttributeError(path.Root("retention_policy"), "Invalid retention policy", err.Error())
+	}
+}
+
+func (a *dbClusterBackupAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data dbClusterBackupModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := a.Client(data.OrganisationID, data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dbCluster, err := client.DBaaS().GetDbCluster(ctx, data.DbClusterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get db cluster", err.Error())
+		return
+	}
+	if dbCluster.Status != dbaas.DbClusterStatusReady {
+		resp.Diagnostics.AddError("Failed to back up db cluster", fmt.Sprintf("db cluster is not ready: %s", dbCluster.Status))
+		return
+	}
+
+	createBackup := dbaas.CreateDbClusterBackupRequest{
+		Name: data.Name.ValueString(),
+	}
+	if createBackup.Name == "" {
+		createBackup.Name = defaultDbClusterBackupName(time.Now())
+	}
+	if description := data.Description.ValueString(); description != "" {
+		createBackup.Description = convert.Ptr(description)
+	}
+	if retentionPolicy := data.RetentionPolicy.ValueString(); retentionPolicy != "" {
+		createBackup.RetentionPolicy = convert.Ptr(retentionPolicy)
+	}
+
+	backup, err := client.DBaaS().CreateDbBackup(ctx, dbCluster.Identity, createBackup)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create db backup", err.Error())
+		return
+	}
+	if backup == nil {
+		resp.Diagnostics.AddError("Failed to create db backup", "db backup was not returned after creation")
+		return
+	}
+	provider.SendActionProgress(resp, fmt.Sprintf("Started db backup %s (%s)", createBackup.Name, backup.Identity))
+
+	if !data.WaitUntilComplete.IsNull() && !data.WaitUntilComplete.ValueBool() {
+		return
+	}
+
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, dbClusterBackupActionTimeout)
+	defer cancel()
+	if _, err := waitForCompletedDbBackup(ctxWithTimeout, client, backup.Identity); err != nil {
+		resp.Diagnostics.AddError("Failed to complete db backup", err.Error())
+		return
+	}
+	provider.SendActionProgress(resp, fmt.Sprintf("Completed db backup %s (%s)", createBackup.Name, backup.Identity))
+}
+
+// defaultDbClusterBackupName returns the name of a backup taken by the action without a configured name.
+func defaultDbClusterBackupName(now time.Time) string {
+	return "terraform-" + now.UTC().Format("20060102-150405")
+}
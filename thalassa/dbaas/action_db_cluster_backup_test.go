@@ -0,0 +1,17 @@
+package dbaas
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultDbClusterBackupName(t *testing.T) {
+	now := time.Date(2026, 3, 14, 9, 26, 53, 0, time.FixedZone("CET", 3600))
+	name := defaultDbClusterBackupName(now)
+	assert.Equal(t, "terraform-20260314-082653", name)
+
+	_, errs := resourceDbBackup().Schema["name"].ValidateFunc(name, "name")
+	assert.Empty(t, errs)
+}
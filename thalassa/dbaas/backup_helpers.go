@@ -0,0 +1,98 @@
+package dbaas
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thalassa-cloud/client-go/dbaas"
+	"github.com/thalassa-cloud/client-go/thalassa"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/waiter"
+)
+
+// dbBackupCompleted is the wait state of a backup for which isBackupComplete holds.
+const dbBackupCompleted = "completed"
+
+func isBackupComplete(backup *dbaas.DbClusterBackup) bool {
+	if backup == nil {
+		return false
+	}
+	switch backup.Status {
+	case dbaas.ObjectStatusReady, dbaas.ObjectStatus("completed"):
+		return true
+	default:
+		return false
+	}
+}
+
+func waitForCompletedDbBackup(ctx context.Context, client thalassa.Client, backupID string) (*dbaas.DbClusterBackup, error) {
+	return waiter.Waiter[*dbaas.DbClusterBackup]{
+		Description: fmt.Sprintf("db backup %q", backupID),
+		Refresh: func(ctx context.Context) (*dbaas.DbClusterBackup, error) {
+			return client.DBaaS().GetDbBackup(ctx, backupID)
+		},
+		State: func(backup *dbaas.DbClusterBackup) string {
+			if isBackupComplete(backup) {
+				return dbBackupCompleted
+			}
+			return string(backup.Status)
+		},
+		Message: func(backup *dbaas.DbClusterBackup) string { return backup.StatusMessage },
+		Target:  []string{dbBackupCompleted},
+		Failed:  []string{string(dbaas.ObjectStatusFailed), string(dbaas.ObjectStatusDeleting), string(dbaas.ObjectStatusDeleted)},
+		// Backups take minutes to hours, so there is no point in polling often.
+		MinInterval: 5 * time.Second,
+		MaxInterval: time.Minute,
+	}.Wait(ctx)
+}
+
+// setDbBackupState sets the computed attributes of the DB backup resource.
+func setDbBackupState(d interface{ Set(string, any) error }, backup *dbaas.DbClusterBackup) error {
+	values := map[string]any{
+		"backup_trigger":      string(backup.BackupTrigger),
+		"engine_type":         string(backup.EngineType),
+		"engine_version":      backup.EngineVersion,
+		"backup_type":         backup.BackupType,
+		"online":              backup.Online,
+		"status":              string(backup.Status),
+		"status_message":      backup.StatusMessage,
+		"begin_lsn":           backup.BeginLSN,
+		"end_lsn":             backup.EndLSN,
+		"begin_wal":           backup.BeginWAL,
+		"end_wal":             backup.EndWAL,
+		"created_at":          backup.CreatedAt.Format(time.RFC3339),
+		"started_at":          "",
+		"stopped_at":          "",
+		"delete_scheduled_at": "",
+		"db_object_store_id":  "",
+	}
+	if backup.StartedAt != nil {
+		values["started_at"] = backup.StartedAt.Format(time.RFC3339)
+	}
+	if backup.StoppedAt != nil {
+		values["stopped_at"] = backup.StoppedAt.Format(time.RFC3339)
+	}
+	if backup.DeleteScheduledAt != nil {
+		values["delete_scheduled_at"] = backup.DeleteScheduledAt.Format(time.RFC3339)
+	}
+	if backup.DbObjectStore != nil {
+		values["db_object_store_id"] = backup.DbObjectStore.Identity
+	}
+	if backup.Labels != nil {
+		values["labels"] = backup.Labels
+	}
+	if backup.Annotations != nil {
+		values["annotations"] = backup.Annotations
+	}
+	if backup.DbCluster != nil {
+		values["db_cluster_id"] = backup.DbCluster.Identity
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return fmt.Errorf("setting %s: %w", key, err)
+		}
+	}
+	return nil
+}
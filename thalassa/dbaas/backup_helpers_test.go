@@ -2,7 +2,9 @@ package dbaas
 
 import (
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 	"github.com/thalassa-cloud/client-go/dbaas"
 )
@@ -49,3 +51,44 @@ func TestIsBackupComplete(t *testing.T) {
 		})
 	}
 }
+
+func TestSetDbBackupState(t *testing.T) {
+	t.Parallel()
+
+	started := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	backup := &dbaas.DbClusterBackup{
+		Identity:      "dbb-123",
+		DbCluster:     &dbaas.DbCluster{Identity: "dbc-1"},
+		DbObjectStore: &dbaas.DbObjectStore{Identity: "dbos-1"},
+		Labels:        dbaas.Labels{"purpose": "pre-upgrade"},
+		EngineVersion: "15.13",
+		Status:        dbaas.ObjectStatusReady,
+		StartedAt:     &started,
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceDbBackup().Schema, map[string]any{})
+	assert.NoError(t, setDbBackupState(d, backup))
+	assert.Equal(t, "dbc-1", d.Get("db_cluster_id"))
+	assert.Equal(t, "dbos-1", d.Get("db_object_store_id"))
+	assert.Equal(t, "15.13", d.Get("engine_version"))
+	assert.Equal(t, "pre-upgrade", d.Get("labels.purpose"))
+	assert.Equal(t, "2025-03-01T10:00:00Z", d.Get("started_at"))
+	assert.Equal(t, "", d.Get("stopped_at"))
+}
+
+func TestResourceDbBackupSchema(t *testing.T) {
+	t.Parallel()
+
+	s := resourceDbBackup().Schema
+	for name, attr := range s {
+		if attr.Computed && !attr.Optional {
+			continue
+		}
+		switch name {
+		case "retain_on_destroy", "wait_until_complete":
+			assert.False(t, attr.ForceNew, name)
+		default:
+			assert.True(t, attr.ForceNew, name)
+		}
+	}
+}
@@ -30,6 +30,11 @@ func dataSourceDbBackup() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation. If not provided, the organisation of the (Terraform) provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"db_cluster_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -0,0 +1,173 @@
+package dbaas
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/thalassa-cloud/client-go/dbaas"
+	tcclient "github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+func dataSourceDbObjectStore() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get a DB object store by ID or name",
+		ReadContext: dataSourceDbObjectStoreRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				Description:  "The ID of the DB object store",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				Description:  "The name of the DB object store",
+			},
+			"organisation_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reference to the Organisation of the DB object store. If not provided, the organisation of the (Terraform) provider will be used.",
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Region of the DB object store (identity or slug). When looking up by name, only object stores in this region are considered.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the DB object store",
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The labels of the DB object store",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The annotations of the DB object store",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"retention_policy": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Retention policy for the backups in the object store",
+			},
+			"delete_protection": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the DB object store is protected from deletion",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the DB object store",
+			},
+			"status_message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Details about the status of the DB object store",
+			},
+			"bucket_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identity of the object storage bucket holding the backups",
+			},
+			"bucket_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the object storage bucket holding the backups",
+			},
+			"bucket_endpoint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Endpoint of the object storage bucket",
+			},
+			"service_account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identity of the service account with access to the bucket",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation timestamp (RFC3339)",
+			},
+		},
+	}
+}
+
+func dataSourceDbObjectStoreRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var store *dbaas.DbObjectStore
+	if id := d.Get("id").(string); id != "" {
+		store, err = client.DBaaS().GetDbObjectStore(ctx, id)
+		if err != nil {
+			if tcclient.IsNotFound(err) {
+				return diag.Errorf("db object store %q not found", id)
+			}
+			return diag.FromErr(fmt.Errorf("error getting db object store: %w", err))
+		}
+	} else {
+		stores, err := client.DBaaS().ListDbObjectStores(ctx, &dbaas.ListDbObjectStoresRequest{})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error listing db object stores: %w", err))
+		}
+		store, err = findDbObjectStore(stores, d.Get("name").(string), d.Get("region").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if store == nil {
+		return diag.Errorf("db object store not found")
+	}
+
+	d.SetId(store.Identity)
+	if err := setDbObjectStoreState(d, store); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// findDbObjectStore returns the single object store with the given name, optionally limited to a region.
+func findDbObjectStore(stores []dbaas.DbObjectStore, name, region string) (*dbaas.DbObjectStore, error) {
+	var matches []dbaas.DbObjectStore
+	for _, store := range stores {
+		if !strings.EqualFold(store.Name, name) {
+			continue
+		}
+		if region != "" && (store.Region == nil || (store.Region.Identity != region && store.Region.Slug != region)) {
+			continue
+		}
+		matches = append(matches, store)
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("db object store %q not found", name)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("found %d db object stores named %q; set region or id to select one", len(matches), name)
+	}
+}
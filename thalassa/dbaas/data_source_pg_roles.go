@@ -35,6 +35,11 @@ func dataSourcePgRoles() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation of the Db Cluster. If not provided, the organisation of the (Terraform) provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"connection_limit": {
 				Type:        schema.TypeInt,
 				Computed:    true,
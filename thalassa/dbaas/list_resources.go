@@ -0,0 +1,29 @@
+package dbaas
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+
+	"github.com/thalassa-cloud/client-go/dbaas"
+	"github.com/thalassa-cloud/client-go/filters"
+	"github.com/thalassa-cloud/client-go/thalassa"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+// NewDbClusterListResource returns the thalassa_dbaas_db_cluster list resource.
+func NewDbClusterListResource() list.ListResource {
+	return &provider.SDKListResource{
+		TypeName:    "dbaas_db_cluster",
+		Description: "database clusters",
+		Resource:    resourceDbCluster,
+		Regional:    true,
+		ListObjects: func(ctx context.Context, client thalassa.Client, requestFilters []filters.Filter) ([]provider.ListedObject, error) {
+			clusters, err := client.DBaaS().ListDbClusters(ctx, &dbaas.ListDbClustersRequest{Filters: requestFilters})
+			return provider.ListedObjects(clusters, func(cluster dbaas.DbCluster) provider.ListedObject {
+				return provider.ListedObject{ID: cluster.Identity, Name: cluster.Name}
+			}), err
+		},
+	}
+}
@@ -0,0 +1,64 @@
+package dbaas
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/thalassa-cloud/client-go/dbaas"
+)
+
+var retentionPolicyPattern = regexp.MustCompile(`^[1-9][0-9]*d$`)
+
+func validateRetentionPolicy(val any, _ string) (warns []string, errs []error) {
+	if !retentionPolicyPattern.MatchString(val.(string)) {
+		errs = append(errs, fmt.Errorf("retention_policy must be in the format of <number>d (e.g. 7d, 30d, 90d)"))
+	}
+	return
+}
+
+// setDbObjectStoreState sets the attributes shared by the DB object store resource and data source.
+// The region is kept as configured when it refers to the same region by slug or identity.
+func setDbObjectStoreState(d interface {
+	Get(string) any
+	Set(string, any) error
+}, store *dbaas.DbObjectStore) error {
+	values := map[string]any{
+		"name":               store.Name,
+		"description":        store.Description,
+		"labels":             store.Labels,
+		"annotations":        store.Annotations,
+		"retention_policy":   store.RetentionPolicy,
+		"delete_protection":  store.DeleteProtection,
+		"status":             string(store.Status),
+		"status_message":     store.StatusMessage,
+		"created_at":         store.CreatedAt.Format(time.RFC3339),
+		"bucket_id":          "",
+		"bucket_name":        "",
+		"bucket_endpoint":    "",
+		"service_account_id": "",
+	}
+	if store.Region != nil {
+		switch d.Get("region").(string) {
+		case store.Region.Identity:
+			values["region"] = store.Region.Identity
+		default:
+			values["region"] = store.Region.Slug
+		}
+	}
+	if store.ObjectStorageBucket != nil {
+		values["bucket_id"] = store.ObjectStorageBucket.Identity
+		values["bucket_name"] = store.ObjectStorageBucket.Name
+		values["bucket_endpoint"] = store.ObjectStorageBucket.Endpoint
+	}
+	if store.ServiceAccount != nil {
+		values["service_account_id"] = store.ServiceAccount.Identity
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return fmt.Errorf("setting %s: %w", key, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package dbaas
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/thalassa-cloud/client-go/dbaas"
+	"github.com/thalassa-cloud/client-go/iaas"
+	"github.com/thalassa-cloud/client-go/objectstorage"
+)
+
+func TestValidateRetentionPolicy(t *testing.T) {
+	t.Parallel()
+
+	for _, value := range []string{"7d", "30d", "365d"} {
+		_, errs := validateRetentionPolicy(value, "retention_policy")
+		assert.Empty(t, errs, value)
+	}
+	for _, value := range []string{"", "0d", "30", "4w", "d"} {
+		_, errs := validateRetentionPolicy(value, "retention_policy")
+		assert.NotEmpty(t, errs, value)
+	}
+}
+
+func TestSetDbObjectStoreState(t *testing.T) {
+	t.Parallel()
+
+	store := &dbaas.DbObjectStore{
+		Identity:        "dbos-123",
+		Name:            "backups",
+		RetentionPolicy: "30d",
+		Status:          dbaas.ObjectStatusReady,
+		CreatedAt:       time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		Region:          &iaas.Region{Identity: "region-1", Slug: "nl-01"},
+		ObjectStorageBucket: &objectstorage.ObjectStorageBucket{
+			Identity: "bucket-1",
+			Name:     "dbos-backups",
+			Endpoint: "https://objects.example.com",
+		},
+	}
+
+	t.Run("keeps region identity", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, resourceDbObjectStore().Schema, map[string]any{"region": "region-1"})
+		assert.NoError(t, setDbObjectStoreState(d, store))
+		assert.Equal(t, "region-1", d.Get("region"))
+		assert.Equal(t, "dbos-backups", d.Get("bucket_name"))
+		assert.Equal(t, "", d.Get("service_account_id"))
+		assert.Equal(t, "2025-01-02T03:04:05Z", d.Get("created_at"))
+	})
+
+	t.Run("defaults to region slug", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, dataSourceDbObjectStore().Schema, map[string]any{})
+		assert.NoError(t, setDbObjectStoreState(d, store))
+		assert.Equal(t, "nl-01", d.Get("region"))
+	})
+}
+
+func TestFindDbObjectStore(t *testing.T) {
+	t.Parallel()
+
+	stores := []dbaas.DbObjectStore{
+		{Identity: "a", Name: "backups", Region: &iaas.Region{Identity: "region-1", Slug: "nl-01"}},
+		{Identity: "b", Name: "backups", Region: &iaas.Region{Identity: "region-2", Slug: "nl-02"}},
+		{Identity: "c", Name: "archive", Region: &iaas.Region{Identity: "region-1", Slug: "nl-01"}},
+	}
+
+	store, err := findDbObjectStore(stores, "Archive", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "c", store.Identity)
+
+	store, err = findDbObjectStore(stores, "backups", "nl-02")
+	assert.NoError(t, err)
+	assert.Equal(t, "b", store.Identity)
+
+	_, err = findDbObjectStore(stores, "backups", "")
+	assert.ErrorContains(t, err, "found 2")
+
+	_, err = findDbObjectStore(stores, "missing", "")
+	assert.ErrorContains(t, err, "not found")
+}
@@ -0,0 +1,298 @@
+package dbaas
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validate "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/thalassa-cloud/client-go/dbaas"
+	tcclient "github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+func resourceDbBackup() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Take an on-demand backup of a database cluster. The backup is taken on creation; changing any argument other than retain_on_destroy and wait_until_complete takes a new backup.",
+		CreateContext: resourceDbBackupCreate,
+		ReadContext:   resourceDbBackupRead,
+		UpdateContext: resourceDbBackupUpdate,
+		DeleteContext: resourceDbBackupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identity of the backup",
+			},
+			"organisation_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Organisation of the backup. If not provided, the organisation of the (Terraform) provider will be used.",
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
+			"db_cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the database cluster to back up",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StringLenBetween(1, 62),
+				Description:  "The name of the backup",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The description of the backup",
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The labels of the backup",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"annotations": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The annotations of the backup",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"retention_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRetentionPolicy,
+				Description:  "How long the platform keeps the backup, in the format <number>d (e.g. 30d). Uses the retention policy of the object store when not set.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary values that take a new backup when changed, e.g. the target engine version of the cluster",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"retain_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Keep the backup when the resource is destroyed or replaced. The backup is then only removed from the Terraform state.",
+			},
+			"wait_until_complete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Wait until the backup has completed",
+			},
+			"backup_trigger": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Trigger of the backup (manual, schedule, system)",
+			},
+			"engine_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the database engine",
+			},
+			"engine_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version of the database engine at the time of the backup",
+			},
+			"backup_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the backup",
+			},
+			"online": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the backup is an online backup",
+			},
+			"db_object_store_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identity of the DB object store holding the backup",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the backup",
+			},
+			"status_message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status message of the backup",
+			},
+			"started_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the backup started (RFC3339 format)",
+			},
+			"stopped_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the backup stopped (RFC3339 format)",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the backup was created (RFC3339 format)",
+			},
+			"delete_scheduled_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the backup is scheduled to be deleted (RFC3339 format), if any",
+			},
+			"begin_lsn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Starting LSN of the backup (PostgreSQL only)",
+			},
+			"end_lsn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Ending LSN of the backup (PostgreSQL only)",
+			},
+			"begin_wal": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Starting WAL of the backup (PostgreSQL only)",
+			},
+			"end_wal": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Ending WAL of the backup (PostgreSQL only)",
+			},
+		},
+	}
+}
+
+func resourceDbBackupCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dbClusterId := d.Get("db_cluster_id").(string)
+	dbCluster, err := client.DBaaS().GetDbCluster(ctx, dbClusterId)
+	if err != nil {
+		if tcclient.IsNotFound(err) {
+			return diag.FromErr(fmt.Errorf("db cluster not found: %w", err))
+		}
+		return diag.FromErr(fmt.Errorf("error getting db cluster: %w", err))
+	}
+	if dbCluster.Status != dbaas.DbClusterStatusReady {
+		return diag.FromErr(fmt.Errorf("db cluster is not ready: %s", dbCluster.Status))
+	}
+
+	createBackup := dbaas.CreateDbClusterBackupRequest{
+		Name:        d.Get("name").(string),
+		Labels:      dbaas.Labels(convert.ConvertToMap(d.Get("labels"))),
+		Annotations: dbaas.Annotations(convert.ConvertToMap(d.Get("annotations"))),
+	}
+	if description, ok := d.GetOk("description"); ok {
+		createBackup.Description = convert.Ptr(description.(string))
+	}
+	if retentionPolicy, ok := d.GetOk("retention_policy"); ok {
+		createBackup.RetentionPolicy = convert.Ptr(retentionPolicy.(string))
+	}
+
+	backup, err := client.DBaaS().CreateDbBackup(ctx, dbCluster.Identity, createBackup)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating db backup: %w", err))
+	}
+	if backup == nil {
+		return diag.FromErr(fmt.Errorf("db backup was not returned after creation"))
+	}
+	d.SetId(backup.Identity)
+
+	if d.Get("wait_until_complete").(bool) {
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+		defer cancel()
+		if _, err := waitForCompletedDbBackup(ctxWithTimeout, client, backup.Identity); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDbBackupRead(ctx, d, m)
+}
+
+func resourceDbBackupRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backup, err := client.DBaaS().GetDbBackup(ctx, d.Id())
+	if err != nil {
+		if tcclient.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error getting db backup: %w", err))
+	}
+	if backup == nil || backup.Status == dbaas.ObjectStatusDeleted {
+		d.SetId("")
+		return nil
+	}
+
+	if err := setDbBackupState(d, backup); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// resourceDbBackupUpdate only stores retain_on_destroy and wait_until_complete; all other arguments take a new backup.
+func resourceDbBackupUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	return resourceDbBackupRead(ctx, d, m)
+}
+
+func resourceDbBackupDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	if d.Get("retain_on_destroy").(bool) {
+		tflog.Info(ctx, "retaining db backup, removing it from state only", map[string]any{
+			"backup_id": d.Id(),
+		})
+		d.SetId("")
+		return nil
+	}
+
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.DBaaS().DeleteDbBackup(ctx, d.Id()); err != nil {
+		if tcclient.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error deleting db backup: %w", err))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -15,12 +15,13 @@ import (
 	"github.com/thalassa-cloud/client-go/dbaas"
 	"github.com/thalassa-cloud/client-go/iaas"
 	tcclient "github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/thalassa"
 	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
 	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
 )
 
 func resourceDbCluster() *schema.Resource {
-	return &schema.Resource{
+	return provider.WithIDIdentity(&schema.Resource{
 		Description:   "Create an DB Cluster",
 		CreateContext: resourceDbClusterCreate,
 		ReadContext:   resourceDbClusterRead,
@@ -84,6 +85,12 @@ func resourceDbCluster() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation of the Db Cluster. If not provided, the organisation of the (Terraform) provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"description": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -319,7 +326,7 @@ func resourceDbCluster() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-	}
+	})
 }
 
 func resourceDbClusterCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics { //nolint:gocyclo // create validates many optional DB cluster fields
@@ -810,26 +817,14 @@ func resourceDbClusterUpdate(ctx context.Context, d *schema.ResourceData, m any)
 	return resourceDbClusterRead(ctx, d, m)
 }
 
-func isBackupComplete(backup *dbaas.DbClusterBackup) bool {
-	if backup == nil {
-		return false
-	}
-	switch backup.Status {
-	case dbaas.ObjectStatusReady, dbaas.ObjectStatus("completed"):
-		return true
-	default:
-		return false
-	}
-}
-
-func createBackupBeforeDestroy(ctx context.Context, dbaasClient *dbaas.Client, dbClusterIdentity string, timeoutMinutes int) diag.Diagnostics {
+func createBackupBeforeDestroy(ctx context.Context, client thalassa.Client, dbClusterIdentity string, timeoutMinutes int) diag.Diagnostics {
 	backupName := fmt.Sprintf("terraform-pre-destroy-%d", time.Now().Unix())
 	createBackup := dbaas.CreateDbClusterBackupRequest{
 		Name:   backupName,
 		Labels: dbaas.Labels{"terraform": "true", "purpose": "pre-destroy"},
 	}
 
-	backup, err := dbaasClient.CreateDbBackup(ctx, dbClusterIdentity, createBackup)
+	backup, err := client.DBaaS().CreateDbBackup(ctx, dbClusterIdentity, createBackup)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to create pre-destroy backup: %w", err))
 	}
@@ -841,38 +836,15 @@ func createBackupBeforeDestroy(ctx context.Context, dbaasClient *dbaas.Client, d
 	ctxWithTimeout, cancel := context.WithTimeout(ctx, time.Duration(timeoutMinutes)*time.Minute)
 	defer cancel()
 
-	for {
-		select {
-		case <-ctxWithTimeout.Done():
-			if ctxWithTimeout.Err() == context.Canceled {
-				return diag.FromErr(fmt.Errorf("pre-destroy backup wait cancelled"))
-			}
-			return diag.FromErr(fmt.Errorf("timeout waiting for pre-destroy backup %q to complete (last status: %s)", backup.Identity, backup.Status))
-		default:
-		}
-
-		backup, err = dbaasClient.GetDbBackup(ctxWithTimeout, backup.Identity)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to retrieve pre-destroy backup: %w", err))
-		}
-
-		if isBackupComplete(backup) {
-			tflog.Info(ctx, "pre-destroy backup completed", map[string]any{
-				"backup_id": backup.Identity,
-				"status":    backup.Status,
-			})
-			return nil
-		}
-		if backup.Status == dbaas.ObjectStatusFailed {
-			return diag.FromErr(fmt.Errorf("pre-destroy backup failed: %s", backup.StatusMessage))
-		}
-
-		tflog.Debug(ctx, "pre-destroy backup still in progress", map[string]any{
-			"backup_id": backup.Identity,
-			"status":    backup.Status,
-		})
-		time.Sleep(1 * time.Second)
+	backup, err = waitForCompletedDbBackup(ctxWithTimeout, client, backup.Identity)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("pre-destroy backup: %w", err))
 	}
+	tflog.Info(ctx, "pre-destroy backup completed", map[string]any{
+		"backup_id": backup.Identity,
+		"status":    backup.Status,
+	})
+	return nil
 }
 
 func resourceDbClusterDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -894,7 +866,7 @@ func resourceDbClusterDelete(ctx context.Context, d *schema.ResourceData, m any)
 
 	if d.Get("create_backup_before_destroy").(bool) && dbCluster.Status == dbaas.DbClusterStatusReady && dbCluster.DbObjectStore != nil {
 		timeout := d.Get("create_backup_before_destroy_timeout").(int)
-		if diags := createBackupBeforeDestroy(ctx, client.DBaaS(), dbCluster.Identity, timeout); diags != nil {
+		if diags := createBackupBeforeDestroy(ctx, client, dbCluster.Identity, timeout); diags != nil {
 			return diags
 		}
 	}
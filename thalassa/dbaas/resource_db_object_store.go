@@ -0,0 +1,256 @@
+package dbaas
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validate "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/thalassa-cloud/client-go/dbaas"
+	tcclient "github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+func resourceDbObjectStore() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Create a DB object store: an object storage bucket and service account used for database backups. A DB object store can be shared by several database clusters through their db_object_store_id.",
+		CreateContext: resourceDbObjectStoreCreate,
+		ReadContext:   resourceDbObjectStoreRead,
+		UpdateContext: resourceDbObjectStoreUpdate,
+		DeleteContext: resourceDbObjectStoreDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the DB object store",
+			},
+			"organisation_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Organisation of the DB object store. If not provided, the organisation of the (Terraform) provider will be used.",
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.StringLenBetween(1, 62),
+				Description:  "The name of the DB object store",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.StringLenBetween(0, 255),
+				Description:  "The description of the DB object store",
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Default:     make(map[string]string),
+				Optional:    true,
+				Description: "The labels of the DB object store",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"annotations": {
+				Type:        schema.TypeMap,
+				Default:     make(map[string]string),
+				Optional:    true,
+				Description: "The annotations of the DB object store",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Region of the DB object store (identity or slug). Database clusters using the object store must be in the same region.",
+			},
+			"retention_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateRetentionPolicy,
+				Description:  "Retention policy for the backups in the object store, in the format <number>d (e.g. 30d). Uses the platform default when not set.",
+			},
+			"delete_protection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Protect the DB object store from deletion. Must be disabled before the object store can be destroyed.",
+			},
+			"wait_until_ready": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Wait until the DB object store is ready",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the DB object store",
+			},
+			"status_message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Details about the status of the DB object store",
+			},
+			"bucket_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identity of the object storage bucket holding the backups",
+			},
+			"bucket_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the object storage bucket holding the backups",
+			},
+			"bucket_endpoint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Endpoint of the object storage bucket",
+			},
+			"service_account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identity of the service account with access to the bucket",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation timestamp (RFC3339)",
+			},
+		},
+	}
+}
+
+func resourceDbObjectStoreCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	create := dbaas.CreateDbObjectStoreRequest{
+		Name:             d.Get("name").(string),
+		Description:      d.Get("description").(string),
+		Labels:           dbaas.Labels(convert.ConvertToMap(d.Get("labels"))),
+		Annotations:      dbaas.Annotations(convert.ConvertToMap(d.Get("annotations"))),
+		Region:           d.Get("region").(string),
+		RetentionPolicy:  d.Get("retention_policy").(string),
+		DeleteProtection: d.Get("delete_protection").(bool),
+	}
+
+	store, err := client.DBaaS().CreateDbObjectStore(ctx, create)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating db object store: %w", err))
+	}
+	if store == nil {
+		return diag.FromErr(fmt.Errorf("db object store was not returned after creation"))
+	}
+	d.SetId(store.Identity)
+
+	if d.Get("wait_until_ready").(bool) {
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+		defer cancel()
+		if _, err := waitForReadyDbObjectStore(ctxWithTimeout, client, store.Identity); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDbObjectStoreRead(ctx, d, m)
+}
+
+func resourceDbObjectStoreRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	store, err := client.DBaaS().GetDbObjectStore(ctx, d.Id())
+	if err != nil {
+		if tcclient.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error getting db object store: %w", err))
+	}
+	if store == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if err := setDbObjectStoreState(d, store); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceDbObjectStoreUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	update := dbaas.UpdateDbObjectStoreRequest{
+		Name:             d.Get("name").(string),
+		Description:      d.Get("description").(string),
+		Labels:           dbaas.Labels(convert.ConvertToMap(d.Get("labels"))),
+		Annotations:      dbaas.Annotations(convert.ConvertToMap(d.Get("annotations"))),
+		RetentionPolicy:  d.Get("retention_policy").(string),
+		DeleteProtection: d.Get("delete_protection").(bool),
+	}
+
+	if _, err := client.DBaaS().UpdateDbObjectStore(ctx, d.Id(), update); err != nil {
+		if tcclient.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error updating db object store: %w", err))
+	}
+
+	return resourceDbObjectStoreRead(ctx, d, m)
+}
+
+func resourceDbObjectStoreDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("delete_protection").(bool) {
+		return diag.Errorf("db object store %q has delete_protection enabled; set delete_protection = false and apply before destroying it", d.Id())
+	}
+
+	if err := client.DBaaS().DeleteDbObjectStore(ctx, d.Id()); err != nil {
+		if tcclient.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error deleting db object store: %w", err))
+	}
+
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+	if err := waitForDeletedDbObjectStore(ctxWithTimeout, client, d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -43,6 +43,12 @@ func resourcePgDatabase() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation of the Db Cluster. If not provided, the organisation of the (Terraform) provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"db_cluster_id": {
 				Type:        schema.TypeString,
 				Required:    true,
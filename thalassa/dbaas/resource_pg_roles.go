@@ -22,6 +22,10 @@ func resourcePgRoles() *schema.Resource {
 		ReadContext:   resourcePgRolesRead,
 		UpdateContext: resourcePgRolesUpdate,
 		DeleteContext: resourcePgRolesDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -34,6 +38,12 @@ func resourcePgRoles() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation of the Db Cluster. If not provided, the organisation of the (Terraform) provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -49,10 +59,25 @@ func resourcePgRoles() *schema.Resource {
 				},
 			},
 			"password": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The password of the role",
-				Sensitive:   true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The password of the role. The password is stored in the Terraform state; use password_wo to keep it out of the state.",
+				Sensitive:    true,
+				ExactlyOneOf: []string{"password", "password_wo"},
+			},
+			"password_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				WriteOnly:    true,
+				Sensitive:    true,
+				Description:  "The password of the role, as a write-only argument that is never stored in the Terraform plan or state. Requires Terraform 1.11 or later. Change password_wo_version to update the password.",
+				ExactlyOneOf: []string{"password", "password_wo"},
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"password_wo"},
+				Description:  "Version of password_wo. As write-only arguments are not stored, the password is only updated when this value changes.",
 			},
 			"db_cluster_id": {
 				Type:        schema.TypeString,
@@ -106,9 +131,18 @@ func resourcePgRolesCreate(ctx context.Context, d *schema.ResourceData, m any) d
 		return diag.FromErr(err)
 	}
 
+	password := d.Get("password").(string)
+	if password == "" {
+		passwordWO, diags := convert.WriteOnlyString(d, "password_wo")
+		if diags.HasError() {
+			return diags
+		}
+		password = passwordWO
+	}
+
 	createRole := dbaas.CreatePgRoleRequest{
 		Name:            d.Get("name").(string),
-		Password:        d.Get("password").(string),
+		Password:        password,
 		ConnectionLimit: int64(d.Get("connection_limit").(int)),
 		CreateDb:        d.Get("create_db").(bool),
 		CreateRole:      d.Get("create_role").(bool),
@@ -139,37 +173,19 @@ func resourcePgRolesCreate(ctx context.Context, d *schema.ResourceData, m any) d
 		return diag.FromErr(err)
 	}
 
-	for {
-		select {
-		case <-ctx.Done():
-			return diag.FromErr(fmt.Errorf("timeout waiting for pg role %q to become available", createRole.Name))
-		default:
-		}
-		dbCluster, err = client.DBaaS().GetDbCluster(ctx, dbClusterId)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		found := false
-		if dbCluster.Status == dbaas.DbClusterStatusReady {
-			for _, role := range dbCluster.PostgresRoles {
-				if strings.EqualFold(role.Name, createRole.Name) {
-					d.SetId(role.Identity)
-					_ = d.Set("name", role.Name)
-					_ = d.Set("db_cluster_id", dbClusterId)
-					_ = d.Set("connection_limit", role.ConnectionLimit)
-					_ = d.Set("create_db", role.CreateDb)
-					_ = d.Set("create_role", role.CreateRole)
-					_ = d.Set("login", role.Login)
-					found = true
-					break
-				}
-			}
-		}
-		if found {
-			break
-		}
-		time.Sleep(dbClusterReadyPollInterval)
+	role, err := waitForPgRole(ctx, client, dbClusterId, fmt.Sprintf("pg role %q", createRole.Name), func(role dbaas.DbClusterPostgresRole) bool {
+		return strings.EqualFold(role.Name, createRole.Name)
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	d.SetId(role.Identity)
+	_ = d.Set("name", role.Name)
+	_ = d.Set("db_cluster_id", dbClusterId)
+	_ = d.Set("connection_limit", role.ConnectionLimit)
+	_ = d.Set("create_db", role.CreateDb)
+	_ = d.Set("create_role", role.CreateRole)
+	_ = d.Set("login", role.Login)
 
 	return resourcePgRolesRead(ctx, d, m)
 }
@@ -234,6 +250,15 @@ func resourcePgRolesUpdate(ctx context.Context, d *schema.ResourceData, m any) d
 			}
 		}
 	}
+	if d.HasChange("password_wo_version") {
+		passwordWO, diags := convert.WriteOnlyString(d, "password_wo")
+		if diags.HasError() {
+			return diags
+		}
+		if passwordWO != "" {
+			updateRole.Password = convert.Ptr(passwordWO)
+		}
+	}
 
 	_, err = client.DBaaS().UpdatePgRole(ctx, dbCluster.Identity, d.Get("id").(string), updateRole)
 	if err != nil {
@@ -244,33 +269,19 @@ func resourcePgRolesUpdate(ctx context.Context, d *schema.ResourceData, m any) d
 	}
 
 	roleID := d.Get("id").(string)
-	for {
-		select {
-		case <-ctx.Done():
-			return diag.FromErr(fmt.Errorf("timeout waiting for pg role %q to reflect update", roleID))
-		default:
-		}
-		dbCluster, err = client.DBaaS().GetDbCluster(ctx, dbClusterId)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("error getting db cluster: %w", err))
-		}
-		if dbCluster.Status != dbaas.DbClusterStatusReady {
-			time.Sleep(dbClusterReadyPollInterval)
-			continue
-		}
-		for _, role := range dbCluster.PostgresRoles {
-			if role.Identity == roleID {
-				_ = d.Set("name", role.Name)
-				_ = d.Set("db_cluster_id", dbClusterId)
-				_ = d.Set("connection_limit", role.ConnectionLimit)
-				_ = d.Set("create_db", role.CreateDb)
-				_ = d.Set("create_role", role.CreateRole)
-				_ = d.Set("login", role.Login)
-				return nil
-			}
-		}
-		time.Sleep(dbClusterReadyPollInterval)
+	role, err := waitForPgRole(ctx, client, dbClusterId, fmt.Sprintf("pg role %q", roleID), func(role dbaas.DbClusterPostgresRole) bool {
+		return role.Identity == roleID
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	_ = d.Set("name", role.Name)
+	_ = d.Set("db_cluster_id", dbClusterId)
+	_ = d.Set("connection_limit", role.ConnectionLimit)
+	_ = d.Set("create_db", role.CreateDb)
+	_ = d.Set("create_role", role.CreateRole)
+	_ = d.Set("login", role.Login)
+	return nil
 }
 
 func resourcePgRolesDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -1,6 +1,10 @@
 package dbaas
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
 
 var (
 	ResourcesMap = map[string]*schema.Resource{
@@ -9,6 +13,8 @@ var (
 		"thalassa_dbaas_pg_roles":          resourcePgRoles(),
 		"thalassa_dbaas_pg_grant":          resourcePgGrant(),
 		"thalassa_dbaas_db_backupschedule": resourceDbBackupSchedule(),
+		"thalassa_dbaas_db_object_store":   resourceDbObjectStore(),
+		"thalassa_dbaas_db_backup":         resourceDbBackup(),
 	}
 
 	DataSourcesMap = map[string]*schema.Resource{
@@ -17,5 +23,14 @@ var (
 		"thalassa_dbaas_pg_roles":          dataSourcePgRoles(),
 		"thalassa_dbaas_db_backupschedule": dataSourceDbBackupSchedule(),
 		"thalassa_dbaas_db_backup":         dataSourceDbBackup(),
+		"thalassa_dbaas_db_object_store":   dataSourceDbObjectStore(),
+	}
+
+	Actions = []func() action.Action{
+		NewDbClusterBackupAction,
+	}
+
+	ListResources = []func() list.ListResource{
+		NewDbClusterListResource,
 	}
 )
@@ -3,89 +3,81 @@ package dbaas
 import (
 	"context"
 	"fmt"
-	"time"
 
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/thalassa-cloud/client-go/dbaas"
 	tcclient "github.com/thalassa-cloud/client-go/pkg/client"
 	"github.com/thalassa-cloud/client-go/thalassa"
-)
 
-const (
-	dbClusterReadyPollInterval  = time.Second
-	dbClusterDeletePollInterval = time.Second
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/waiter"
 )
 
-func waitForReadyDbCluster(ctx context.Context, client thalassa.Client, dbClusterID string) (*dbaas.DbCluster, error) {
-	for {
-		select {
-		case <-ctx.Done():
-			if ctx.Err() == context.DeadlineExceeded {
-				return nil, fmt.Errorf("timeout waiting for db cluster %q to become ready", dbClusterID)
-			}
-			return nil, ctx.Err()
-		default:
-		}
-
-		dbCluster, err := client.DBaaS().GetDbCluster(ctx, dbClusterID)
-		if err != nil {
-			return nil, err
-		}
-		if dbCluster == nil {
-			return nil, fmt.Errorf("db cluster %q not found", dbClusterID)
-		}
-		if dbCluster.Status == dbaas.DbClusterStatusReady {
-			return dbCluster, nil
-		}
+func dbClusterStatus(dbCluster *dbaas.DbCluster) string {
+	return string(dbCluster.Status)
+}
 
-		time.Sleep(dbClusterReadyPollInterval)
-	}
+func waitForReadyDbCluster(ctx context.Context, client thalassa.Client, dbClusterID string) (*dbaas.DbCluster, error) {
+	return waiter.Waiter[*dbaas.DbCluster]{
+		Description: fmt.Sprintf("db cluster %q", dbClusterID),
+		Refresh: func(ctx context.Context) (*dbaas.DbCluster, error) {
+			return client.DBaaS().GetDbCluster(ctx, dbClusterID)
+		},
+		State:  dbClusterStatus,
+		Target: []string{string(dbaas.DbClusterStatusReady)},
+	}.Wait(ctx)
 }
 
 func waitForDeletedDbCluster(ctx context.Context, client thalassa.Client, dbClusterID string) error {
-	for {
-		select {
-		case <-ctx.Done():
-			if ctx.Err() == context.DeadlineExceeded {
-				return fmt.Errorf("timeout waiting for db cluster %q to be deleted", dbClusterID)
+	_, err := waiter.Waiter[*dbaas.DbCluster]{
+		Description: fmt.Sprintf("db cluster %q", dbClusterID),
+		Refresh: func(ctx context.Context) (*dbaas.DbCluster, error) {
+			dbCluster, err := client.DBaaS().GetDbCluster(ctx, dbClusterID)
+			if err != nil || dbCluster == nil {
+				return dbCluster, err
 			}
-			return ctx.Err()
-		default:
-		}
-
-		dbCluster, err := client.DBaaS().GetDbCluster(ctx, dbClusterID)
-		if err != nil {
-			if tcclient.IsNotFound(err) {
-				return nil
+			switch dbCluster.Status {
+			case dbaas.DbClusterStatusReady, dbaas.DbClusterStatusUpdating:
+				// The delete API can return before the cluster transitions to deleting.
+				if err := client.DBaaS().DeleteDbCluster(ctx, dbClusterID); err != nil && !tcclient.IsNotFound(err) {
+					return nil, fmt.Errorf("failed to re-issue delete for db cluster %q: %w", dbClusterID, err)
+				}
 			}
-			return err
-		}
-		if dbCluster == nil {
-			return nil
-		}
+			return dbCluster, nil
+		},
+		State:          dbClusterStatus,
+		Target:         []string{string(dbaas.DbClusterStatusDeleted)},
+		TargetNotFound: true,
+	}.Wait(ctx)
+	return err
+}
+
+// pgRoleAvailable is the wait state of a db cluster that is ready and has the awaited role.
+const pgRoleAvailable = "available"
 
-		switch dbCluster.Status {
-		case dbaas.DbClusterStatusDeleted:
-			return nil
-		case dbaas.DbClusterStatusDeleting:
-			tflog.Debug(ctx, "db cluster deletion in progress", map[string]any{
-				"cluster_id": dbClusterID,
-				"status":     dbCluster.Status,
-			})
-		case dbaas.DbClusterStatusReady, dbaas.DbClusterStatusUpdating:
-			// The delete API can return before the cluster transitions to deleting.
-			if err := client.DBaaS().DeleteDbCluster(ctx, dbClusterID); err != nil && !tcclient.IsNotFound(err) {
-				return fmt.Errorf("failed to re-issue delete for db cluster %q: %w", dbClusterID, err)
+// waitForPgRole waits until the db cluster is ready and has a role matching the given function.
+func waitForPgRole(ctx context.Context, client thalassa.Client, dbClusterID, description string, match func(dbaas.DbClusterPostgresRole) bool) (*dbaas.DbClusterPostgresRole, error) {
+	findRole := func(dbCluster *dbaas.DbCluster) *dbaas.DbClusterPostgresRole {
+		for i, role := range dbCluster.PostgresRoles {
+			if match(role) {
+				return &dbCluster.PostgresRoles[i]
 			}
-		case dbaas.DbClusterStatusFailed:
-			return fmt.Errorf("db cluster %q failed to delete (status: %s)", dbClusterID, dbCluster.Status)
-		default:
-			tflog.Debug(ctx, "waiting for db cluster deletion", map[string]any{
-				"cluster_id": dbClusterID,
-				"status":     dbCluster.Status,
-			})
 		}
-
-		time.Sleep(dbClusterDeletePollInterval)
+		return nil
+	}
+	dbCluster, err := waiter.Waiter[*dbaas.DbCluster]{
+		Description: description,
+		Refresh: func(ctx context.Context) (*dbaas.DbCluster, error) {
+			return client.DBaaS().GetDbCluster(ctx, dbClusterID)
+		},
+		State: func(dbCluster *dbaas.DbCluster) string {
+			if dbCluster.Status == dbaas.DbClusterStatusReady && findRole(dbCluster) != nil {
+				return pgRoleAvailable
+			}
+			return dbClusterStatus(dbCluster)
+		},
+		Target: []string{pgRoleAvailable},
+	}.Wait(ctx)
+	if err != nil {
+		return nil, err
 	}
+	return findRole(dbCluster), nil
 }
@@ -0,0 +1,37 @@
+package dbaas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thalassa-cloud/client-go/dbaas"
+	"github.com/thalassa-cloud/client-go/thalassa"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/waiter"
+)
+
+func dbObjectStoreWaiter(client thalassa.Client, dbObjectStoreID string) waiter.Waiter[*dbaas.DbObjectStore] {
+	return waiter.Waiter[*dbaas.DbObjectStore]{
+		Description: fmt.Sprintf("db object store %q", dbObjectStoreID),
+		Refresh: func(ctx context.Context) (*dbaas.DbObjectStore, error) {
+			return client.DBaaS().GetDbObjectStore(ctx, dbObjectStoreID)
+		},
+		State:   func(store *dbaas.DbObjectStore) string { return string(store.Status) },
+		Message: func(store *dbaas.DbObjectStore) string { return store.StatusMessage },
+	}
+}
+
+func waitForReadyDbObjectStore(ctx context.Context, client thalassa.Client, dbObjectStoreID string) (*dbaas.DbObjectStore, error) {
+	w := dbObjectStoreWaiter(client, dbObjectStoreID)
+	w.Target = []string{string(dbaas.ObjectStatusReady)}
+	w.Failed = []string{string(dbaas.ObjectStatusFailed), string(dbaas.ObjectStatusDeleting), string(dbaas.ObjectStatusDeleted)}
+	return w.Wait(ctx)
+}
+
+func waitForDeletedDbObjectStore(ctx context.Context, client thalassa.Client, dbObjectStoreID string) error {
+	w := dbObjectStoreWaiter(client, dbObjectStoreID)
+	w.Target = []string{string(dbaas.ObjectStatusDeleted)}
+	w.TargetNotFound = true
+	_, err := w.Wait(ctx)
+	return err
+}
@@ -0,0 +1,32 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+
+	tcdns "github.com/thalassa-cloud/client-go/dns"
+	"github.com/thalassa-cloud/client-go/filters"
+	"github.com/thalassa-cloud/client-go/thalassa"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+// NewDnsZoneListResource returns the thalassa_dns_zone list resource. DNS zones are global, so only labels can be filtered on.
+func NewDnsZoneListResource() list.ListResource {
+	return &provider.SDKListResource{
+		TypeName:    "dns_zone",
+		Description: "DNS zones",
+		Resource:    ResourceDnsZone,
+		ListObjects: func(ctx context.Context, client thalassa.Client, requestFilters []filters.Filter) ([]provider.ListedObject, error) {
+			zoneFilters := make([]tcdns.ListZonesFilter, 0, len(requestFilters))
+			for _, filter := range requestFilters {
+				zoneFilters = append(zoneFilters, filter)
+			}
+			zones, err := client.DNS().ListZones(ctx, &tcdns.ListZonesRequest{Filters: zoneFilters})
+			return provider.ListedObjects(zones, func(zone tcdns.DnsZone) provider.ListedObject {
+				return provider.ListedObject{ID: zone.Identity, Name: zone.Name}
+			}), err
+		},
+	}
+}
@@ -36,6 +36,12 @@ func ResourceDnsRecord() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"zone_id": {
 				Type:        schema.TypeString,
 				Required:    true,
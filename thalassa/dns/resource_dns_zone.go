@@ -15,7 +15,7 @@ import (
 )
 
 func ResourceDnsZone() *schema.Resource {
-	return &schema.Resource{
+	return provider.WithIDIdentity(&schema.Resource{
 		Description:   "Create and manage a DNS zone in Thalassa Cloud",
 		CreateContext: resourceDnsZoneCreate,
 		ReadContext:   resourceDnsZoneRead,
@@ -35,6 +35,12 @@ func ResourceDnsZone() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"zone_name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -72,7 +78,7 @@ func ResourceDnsZone() *schema.Resource {
 				Computed: true,
 			},
 		},
-	}
+	})
 }
 
 func resourceDnsZoneCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
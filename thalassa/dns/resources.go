@@ -1,6 +1,9 @@
 package dns
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
 
 var ResourcesMap = map[string]*schema.Resource{
 	"thalassa_dns_zone":        ResourceDnsZone(),
@@ -9,3 +12,7 @@ var ResourcesMap = map[string]*schema.Resource{
 }
 
 var DataSourcesMap = map[string]*schema.Resource{}
+
+var ListResources = []func() list.ListResource{
+	NewDnsZoneListResource,
+}
@@ -0,0 +1,148 @@
+package thalassa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/containerregistry"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/dbaas"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/dns"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/iaas"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/kms"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/kubernetes"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/objectstorage"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/secrets"
+)
+
+// frameworkProvider serves the parts of the provider that need the plugin framework, such as ephemeral
+// resources, provider functions, actions and list resources. It is muxed with the SDKv2 provider and shares its configuration schema and configured provider.
+type frameworkProvider struct {
+	sdkProvider *schema.Provider
+}
+
+var (
+	_ fwprovider.ProviderWithEphemeralResources = &frameworkProvider{}
+	_ fwprovider.ProviderWithFunctions          = &frameworkProvider{}
+	_ fwprovider.ProviderWithActions            = &frameworkProvider{}
+	_ fwprovider.ProviderWithListResources      = &frameworkProvider{}
+)
+
+// NewFrameworkProvider returns the plugin framework provider, which serves the schema and the configured provider of
+// the SDKv2 provider.
+func NewFrameworkProvider(sdkProvider *schema.Provider) fwprovider.Provider {
+	return &frameworkProvider{sdkProvider: sdkProvider}
+}
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ fwprovider.MetadataRequest, resp *fwprovider.MetadataResponse) {
+	resp.TypeName = "thalassa"
+}
+
+// Schema mirrors the SDKv2 provider schema, as muxed providers must serve identical provider schemas.
+func (p *frameworkProvider) Schema(_ context.Context, _ fwprovider.SchemaRequest, resp *fwprovider.SchemaResponse) {
+	attributes := make(map[string]fwschema.Attribute, len(p.sdkProvider.Schema))
+	for name, s := range p.sdkProvider.Schema {
+		switch s.Type {
+		case schema.TypeString:
+			attributes[name] = fwschema.StringAttribute{
+				Optional:    s.Optional,
+				Required:    s.Required,
+				Sensitive:   s.Sensitive,
+				Description: s.Description,
+			}
+		case schema.TypeBool:
+			attributes[name] = fwschema.BoolAttribute{
+				Optional:    s.Optional,
+				Required:    s.Required,
+				Sensitive:   s.Sensitive,
+				Description: s.Description,
+			}
+		case schema.TypeInt:
+			attributes[name] = fwschema.Int64Attribute{
+				Optional:    s.Optional,
+				Required:    s.Required,
+				Sensitive:   s.Sensitive,
+				Description: s.Description,
+			}
+		case schema.TypeFloat:
+			attributes[name] = fwschema.Float64Attribute{
+				Optional:    s.Optional,
+				Required:    s.Required,
+				Sensitive:   s.Sensitive,
+				Description: s.Description,
+			}
+		default:
+			resp.Diagnostics.AddError("Unsupported provider attribute", fmt.Sprintf("provider attribute %q has unsupported type %s", name, s.Type))
+		}
+	}
+	resp.Schema = fwschema.Schema{Attributes: attributes}
+}
+
+func (p *frameworkProvider) Configure(_ context.Context, req fwprovider.ConfigureRequest, resp *fwprovider.ConfigureResponse) {
+	if !req.Config.Raw.IsFullyKnown() {
+		// Unknown values are reported by the SDKv2 provider; ephemeral resources, actions and list resources report the provider as unconfigured.
+		return
+	}
+
+	// The mux server configures the SDKv2 provider first, so both halves share its clients, token sources and quota
+	// ledger. When its configuration failed, the mux server does not configure this provider at all.
+	configured, ok := p.sdkProvider.Meta().(provider.ConfiguredProvider)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to configure the Thalassa Cloud provider", "The SDKv2 provider was not configured before the plugin framework provider.")
+		return
+	}
+	resp.EphemeralResourceData = configured
+	resp.ActionData = configured
+	resp.ListResourceData = configured
+}
+
+func (p *frameworkProvider) Resources(_ context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *frameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return JoinSlices(
+		kubernetes.EphemeralResources,
+		secrets.EphemeralResources,
+	)
+}
+
+func (p *frameworkProvider) Functions(_ context.Context) []func() function.Function {
+	return JoinSlices(
+		iaas.Functions,
+		objectstorage.Functions,
+	)
+}
+
+func (p *frameworkProvider) Actions(_ context.Context) []func() action.Action {
+	return JoinSlices(
+		iaas.Actions,
+		kms.Actions,
+		containerregistry.Actions,
+		kubernetes.Actions,
+		dbaas.Actions,
+	)
+}
+
+func (p *frameworkProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return JoinSlices(
+		iaas.ListResources,
+		kubernetes.ListResources,
+		dbaas.ListResources,
+		dns.ListResources,
+	)
+}
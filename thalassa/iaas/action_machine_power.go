@@ -0,0 +1,186 @@
+package iaas
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/thalassa-cloud/client-go/iaas"
+	"github.com/thalassa-cloud/client-go/thalassa"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+const (
+	machinePowerTimeout = 10 * time.Minute
+)
+
+// machinePowerOperation describes a power operation on a virtual machine instance.
+type machinePowerOperation struct {
+	name        string
+	description string
+	// targetStatuses are the machine statuses that mark the operation as complete.
+	targetStatuses []string
+	// transitions is set when the machine is already in a target status before the operation, so the operation is
+	// only complete once the machine transitioned and returned to a target status.
+	transitions bool
+	invoke      func(ctx context.Context, client thalassa.Client, machineID string) error
+}
+
+var (
+	machineStartOperation = machinePowerOperation{
+		name:           "start",
+		description:    "Start a stopped virtual machine instance",
+		targetStatuses: []string{"running", "ready"},
+		invoke: func(ctx context.Context, client thalassa.Client, machineID string) error {
+			return client.IaaS().MachineStart(ctx, machineID)
+		},
+	}
+	machineStopOperation = machinePowerOperation{
+		name:           "stop",
+		description:    "Stop a running virtual machine instance",
+		targetStatuses: []string{"stopped"},
+		invoke: func(ctx context.Context, client thalassa.Client, machineID string) error {
+			return client.IaaS().MachineStop(ctx, machineID)
+		},
+	}
+	machineRestartOperation = machinePowerOperation{
+		name:           "restart",
+		description:    "Restart a virtual machine instance",
+		targetStatuses: []string{"running", "ready"},
+		transitions:    true,
+		invoke: func(ctx context.Context, client thalassa.Client, machineID string) error {
+			return client.IaaS().MachineRestart(ctx, machineID)
+		},
+	}
+)
+
+// applyMachinePowerOperation invokes the power operation on the machine and waits until it is complete.
+func applyMachinePowerOperation(ctx context.Context, client thalassa.Client, machineID string, operation machinePowerOperation) (*iaas.Machine, error) {
+	since, err := invokeMachinePowerOperation(ctx, client, machineID, operation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to %s virtual machine instance: %w", operation.name, err)
+	}
+	return waitForMachinePowerOperation(ctx, client, machineID, operation, since)
+}
+
+// invokeMachinePowerOperation invokes the power operation on the machine. For operations that transition the machine,
+// it returns the last status transition of the machine before the operation.
+func invokeMachinePowerOperation(ctx context.Context, client thalassa.Client, machineID string, operation machinePowerOperation) (time.Time, error) {
+	var since time.Time
+	if operation.transitions {
+		machine, err := client.IaaS().GetMachine(ctx, machineID)
+		if err != nil {
+			return since, fmt.Errorf("reading virtual machine instance: %w", err)
+		}
+		since = machine.Status.LastTransitionTime
+	}
+	return since, operation.invoke(ctx, client, machineID)
+}
+
+// waitForMachinePowerOperation waits until the power operation invoked on the machine is complete.
+func waitForMachinePowerOperation(ctx context.Context, client thalassa.Client, machineID string, operation machinePowerOperation, since time.Time) (*iaas.Machine, error) {
+	if operation.transitions {
+		return waitForMachineStatusTransition(ctx, client, machineID, since, operation.targetStatuses)
+	}
+	return waitForMachineStatus(ctx, client, machineID, operation.targetStatuses)
+}
+
+var _ action.ActionWithConfigure = &machinePowerAction{}
+
+// NewMachineStartAction returns the thalassa_machine_start action.
+func NewMachineStartAction() action.Action {
+	return &machinePowerAction{operation: machineStartOperation}
+}
+
+// NewMachineStopAction returns the thalassa_machine_stop action.
+func NewMachineStopAction() action.Action {
+	return &machinePowerAction{operation: machineStopOperation}
+}
+
+// NewMachineRestartAction returns the thalassa_machine_restart action.
+func NewMachineRestartAction() action.Action {
+	return &machinePowerAction{operation: machineRestartOperation}
+}
+
+type machinePowerAction struct {
+	provider.ActionBase
+	operation machinePowerOperation
+}
+
+type machinePowerModel struct {
+	MachineID         types.String `tfsdk:"machine_id"`
+	OrganisationID    types.String `tfsdk:"organisation_id"`
+	ProjectID         types.String `tfsdk:"project_id"`
+	WaitUntilComplete types.Bool   `tfsdk:"wait_until_complete"`
+}
+
+func (a *machinePowerAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_machine_" + a.operation.name
+}
+
+func (a *machinePowerAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	waitDescription := fmt.Sprintf("Wait until the virtual machine instance is %s. Defaults to true.", a.operation.targetStatuses[0])
+	if a.operation.transitions {
+		waitDescription = fmt.Sprintf("Wait until the virtual machine instance has left and returned to the %s status. Defaults to true.", a.operation.targetStatuses[0])
+	}
+	resp.Schema = schema.Schema{
+		Description: a.operation.description,
+		Attributes: map[string]schema.Attribute{
+			"machine_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the virtual machine instance",
+			},
+			"organisation_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Reference to the Organisation of the virtual machine instance. If not provided, the organisation of the (Terraform) provider will be used.",
+			},
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
+			"wait_until_complete": schema.BoolAttribute{
+				Optional:    true,
+				Description: waitDescription,
+			},
+		},
+	}
+}
+
+func (a *machinePowerAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data machinePowerModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := a.Client(data.OrganisationID, data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	machineID := data.MachineID.ValueString()
+	since, err := invokeMachinePowerOperation(ctx, client, machineID, a.operation)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to %s virtual machine instance", a.operation.name), err.Error())
+		return
+	}
+	provider.SendActionProgress(resp, fmt.Sprintf("Requested %s of virtual machine instance %s", a.operation.name, machineID))
+
+	if !data.WaitUntilComplete.IsNull() && !data.WaitUntilComplete.ValueBool() {
+		return
+	}
+
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, machinePowerTimeout)
+	defer cancel()
+	if _, err := waitForMachinePowerOperation(ctxWithTimeout, client, machineID, a.operation, since); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to %s virtual machine instance", a.operation.name), err.Error())
+		return
+	}
+	provider.SendActionProgress(resp, fmt.Sprintf("Virtual machine instance %s is %s", machineID, a.operation.targetStatuses[0]))
+}
@@ -0,0 +1,64 @@
+package iaas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/thalassa-cloud/client-go/iaas"
+	"github.com/thalassa-cloud/client-go/thalassa"
+)
+
+const machineAttachmentResourceType = "cloud_virtual_machine"
+
+// rejectVolumeShrink fails the plan when the size of a volume in the key is decreased. Volumes can only be expanded.
+func rejectVolumeShrink(key string) schema.CustomizeDiffFunc {
+	return customdiff.ValidateChange(key, func(_ context.Context, oldValue, newValue, _ any) error {
+		oldSize, newSize := oldValue.(int), newValue.(int)
+		if oldSize > 0 && newSize > 0 && newSize < oldSize {
+			return fmt.Errorf("%s cannot be decreased from %d to %d: volumes can only be expanded", key, oldSize, newSize)
+		}
+		return nil
+	})
+}
+
+// machineAttachment returns the attachment of the volume to a virtual machine instance, or nil when the volume is
+// not attached to one.
+func machineAttachment(volume *iaas.Volume) *iaas.VolumeAttachment {
+	for i, attachment := range volume.Attachments {
+		if attachment.AttachedToResourceType == machineAttachmentResourceType {
+			return &volume.Attachments[i]
+		}
+	}
+	return nil
+}
+
+// expandVolume grows the volume to the size, keeping its other attributes, and waits until the new size is reported.
+func expandVolume(ctx context.Context, client thalassa.Client, volume *iaas.Volume, sizeGB int) (*iaas.Volume, error) {
+	_, err := client.IaaS().UpdateVolume(ctx, volume.Identity, iaas.UpdateVolume{
+		Name:             volume.Name,
+		Description:      volume.Description,
+		Labels:           volume.Labels,
+		Annotations:      volume.Annotations,
+		Size:             sizeGB,
+		DeleteProtection: volume.DeleteProtection,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to expand volume %q to %d GB: %w", volume.Identity, sizeGB, err)
+	}
+	return waitForBlockVolumeSize(ctx, client, volume.Identity, sizeGB)
+}
+
+// filesystemResizeWarning tells that the guest must grow the partition and filesystem of a volume that was expanded
+// while it was attached, as the new capacity is not used until then.
+func filesystemResizeWarning(volumeID, machineID string, sizeGB int) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Volume %q was expanded to %d GB while attached", volumeID, sizeGB),
+		Detail: fmt.Sprintf("Virtual machine instance %q does not use the new capacity until the partition and filesystem are grown "+
+			"inside the guest, for example with growpart and resize2fs or xfs_growfs. Root volumes are also grown by cloud-init on the next boot.", machineID),
+	}
+}
@@ -0,0 +1,136 @@
+package iaas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+
+	iaasclient "github.com/thalassa-cloud/client-go/iaas"
+	tcclient "github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/thalassa"
+)
+
+func TestRejectVolumeShrink(t *testing.T) {
+	t.Parallel()
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"size_gb": {Type: schema.TypeInt, Optional: true, Computed: true},
+		},
+		CustomizeDiff: rejectVolumeShrink("size_gb"),
+	}
+
+	tests := []struct {
+		name        string
+		current     string
+		planned     int
+		expectedErr string
+	}{
+		{name: "expanding is allowed", current: "20", planned: 40},
+		{name: "unchanged size is allowed", current: "20", planned: 20},
+		{name: "new volume is allowed", current: "", planned: 20},
+		{
+			name:        "shrinking is rejected",
+			current:     "40",
+			planned:     20,
+			expectedErr: "size_gb cannot be decreased from 40 to 20: volumes can only be expanded",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var state *terraform.InstanceState
+			if tt.current != "" {
+				state = &terraform.InstanceState{ID: "vol-1", Attributes: map[string]string{"id": "vol-1", "size_gb": tt.current}}
+			}
+			config := terraform.NewResourceConfigRaw(map[string]any{"size_gb": tt.planned})
+
+			_, err := resource.Diff(context.Background(), state, config, nil)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMachineAttachment(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, machineAttachment(&iaasclient.Volume{}))
+
+	volume := &iaasclient.Volume{Attachments: []iaasclient.VolumeAttachment{
+		{AttachedToIdentity: "other-1", AttachedToResourceType: "other"},
+		{AttachedToIdentity: "vm-1", AttachedToResourceType: machineAttachmentResourceType, Serial: "serial-1"},
+	}}
+	attachment := machineAttachment(volume)
+	if assert.NotNil(t, attachment) {
+		assert.Equal(t, "vm-1", attachment.AttachedToIdentity)
+		assert.Equal(t, "serial-1", attachment.Serial)
+	}
+}
+
+func TestExpandVolume(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	size, polls := 20, 0
+	var updates []iaasclient.UpdateVolume
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		reported := size
+		switch r.Method + " " + r.URL.Path {
+		case "PUT /v1/volumes/vol-1":
+			var update iaasclient.UpdateVolume
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&update))
+			updates = append(updates, update)
+			size = update.Size
+		case "GET /v1/volumes/vol-1":
+			// the first poll still reports the old size, as the expansion is asynchronous
+			if polls++; polls == 1 {
+				reported = 20
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"identity":"vol-1","name":"data","status":"in-use","size":%d}`, reported)
+	}))
+	defer server.Close()
+
+	client, err := thalassa.NewClient(tcclient.WithBaseURL(server.URL), tcclient.WithAuthNone(), tcclient.WithOrganisation("org-test"))
+	assert.NoError(t, err)
+
+	volume := &iaasclient.Volume{
+		Identity:         "vol-1",
+		Name:             "data",
+		Description:      "data volume",
+		Labels:           iaasclient.Labels{"env": "test"},
+		Size:             20,
+		DeleteProtection: true,
+	}
+	expanded, err := expandVolume(context.Background(), client, volume, 40)
+	assert.NoError(t, err)
+	assert.Equal(t, 40, expanded.Size)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []iaasclient.UpdateVolume{{
+		Name:             "data",
+		Description:      "data volume",
+		Labels:           iaasclient.Labels{"env": "test"},
+		Size:             40,
+		DeleteProtection: true,
+	}}, updates)
+	assert.Equal(t, 2, polls)
+}
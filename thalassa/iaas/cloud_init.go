@@ -0,0 +1,100 @@
+package iaas
+
+import (
+	"bytes"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	cloudInitContentTypeCloudConfig = "text/cloud-config"
+	cloudInitContentTypeShellScript = "text/x-shellscript"
+	cloudInitContentTypeIncludeURL  = "text/x-include-url"
+	cloudInitContentTypeBoothook    = "text/cloud-boothook"
+	cloudInitContentTypeJinja2      = "text/jinja2"
+
+	defaultCloudInitBoundary = "MIMEBOUNDARY"
+)
+
+var cloudInitContentTypes = []string{
+	cloudInitContentTypeCloudConfig,
+	cloudInitContentTypeShellScript,
+	cloudInitContentTypeIncludeURL,
+	cloudInitContentTypeBoothook,
+	cloudInitContentTypeJinja2,
+}
+
+// renderCloudInitTemplate renders the content of a cloud init template with the variables, which the template
+// references as {{ .name }}. Referencing a variable that is not set is an error.
+func renderCloudInitTemplate(content string, variables map[string]string) (string, error) {
+	tmpl, err := template.New("cloud_init").Option("missingkey=error").Parse(content)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse cloud init template: %w", err)
+	}
+	var rendered bytes.Buffer
+	if err := tmpl.Execute(&rendered, variables); err != nil {
+		return "", fmt.Errorf("failed to render cloud init template: %w", err)
+	}
+	return rendered.String(), nil
+}
+
+// cloudInitPart is a part of a multipart cloud init document.
+type cloudInitPart struct {
+	contentType string
+	content     string
+	filename    string
+	mergeType   string
+}
+
+// validateCloudConfig checks that the content of a cloud-config part is a YAML mapping.
+func validateCloudConfig(content string) error {
+	var config map[string]any
+	if err := yaml.Unmarshal([]byte(content), &config); err != nil {
+		return fmt.Errorf("invalid cloud-config YAML: %w", err)
+	}
+	return nil
+}
+
+// renderCloudInitMultipart composes the parts into a MIME multipart document, which cloud-init processes part by
+// part in order.
+func renderCloudInitMultipart(parts []cloudInitPart, boundary string) (string, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	if err := writer.SetBoundary(boundary); err != nil {
+		return "", fmt.Errorf("invalid boundary %q: %w", boundary, err)
+	}
+
+	for i, part := range parts {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", part.contentType)
+		header.Set("Content-Transfer-Encoding", "7bit")
+		header.Set("Mime-Version", "1.0")
+		if part.filename != "" {
+			header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", part.filename))
+		}
+		if part.mergeType != "" {
+			header.Set("X-Merge-Type", part.mergeType)
+		}
+		w, err := writer.CreatePart(header)
+		if err != nil {
+			return "", fmt.Errorf("failed to write part %d: %w", i, err)
+		}
+		if _, err := w.Write([]byte(part.content)); err != nil {
+			return "", fmt.Errorf("failed to write part %d: %w", i, err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+
+	var document strings.Builder
+	fmt.Fprintf(&document, "Content-Type: multipart/mixed; boundary=%q\r\n", boundary)
+	document.WriteString("MIME-Version: 1.0\r\n\r\n")
+	document.Write(body.Bytes())
+	return document.String(), nil
+}
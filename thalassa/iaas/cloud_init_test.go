@@ -0,0 +1,78 @@
+package iaas
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderCloudInitTemplate(t *testing.T) {
+	t.Parallel()
+
+	content := "#cloud-config\nhostname: {{ .hostname }}\nruncmd:\n  - echo {{ .environment }}\n"
+
+	rendered, err := renderCloudInitTemplate(content, map[string]string{"hostname": "web-1", "environment": "staging"})
+	assert.NoError(t, err)
+	assert.Equal(t, "#cloud-config\nhostname: web-1\nruncmd:\n  - echo staging\n", rendered)
+
+	_, err = renderCloudInitTemplate(content, map[string]string{"hostname": "web-1"})
+	assert.ErrorContains(t, err, `map has no entry for key "environment"`)
+}
+
+func TestValidateCloudConfig(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, validateCloudConfig("#cloud-config\npackages:\n  - nginx\n"))
+	assert.NoError(t, validateCloudConfig("#cloud-config\n"))
+	assert.ErrorContains(t, validateCloudConfig("#cloud-config\npackages:\n  - nginx\n bad: indent\n"), "invalid cloud-config YAML")
+	assert.ErrorContains(t, validateCloudConfig("- nginx\n"), "invalid cloud-config YAML")
+}
+
+func TestDataSourceCloudInitConfigRead(t *testing.T) {
+	t.Parallel()
+
+	d := schema.TestResourceDataRaw(t, DataSourceCloudInitConfig().Schema, map[string]any{
+		"part": []any{
+			map[string]any{
+				"content": "#cloud-config\npackages:\n  - nginx\n",
+			},
+			map[string]any{
+				"content_type": "text/x-shellscript",
+				"filename":     "setup.sh",
+				"content":      "#!/bin/sh\necho ready\n",
+			},
+		},
+	})
+
+	diags := dataSourceCloudInitConfigRead(context.Background(), d, nil)
+	assert.Empty(t, diags)
+	assert.NotEmpty(t, d.Id())
+	assert.Equal(t, "Content-Type: multipart/mixed; boundary=\"MIMEBOUNDARY\"\r\n"+
+		"MIME-Version: 1.0\r\n"+
+		"\r\n"+
+		"--MIMEBOUNDARY\r\n"+
+		"Content-Transfer-Encoding: 7bit\r\n"+
+		"Content-Type: text/cloud-config\r\n"+
+		"Mime-Version: 1.0\r\n"+
+		"\r\n"+
+		"#cloud-config\npackages:\n  - nginx\n"+
+		"\r\n--MIMEBOUNDARY\r\n"+
+		"Content-Disposition: attachment; filename=\"setup.sh\"\r\n"+
+		"Content-Transfer-Encoding: 7bit\r\n"+
+		"Content-Type: text/x-shellscript\r\n"+
+		"Mime-Version: 1.0\r\n"+
+		"\r\n"+
+		"#!/bin/sh\necho ready\n"+
+		"\r\n--MIMEBOUNDARY--\r\n", d.Get("rendered"))
+
+	invalid := schema.TestResourceDataRaw(t, DataSourceCloudInitConfig().Schema, map[string]any{
+		"part": []any{
+			map[string]any{"content": "packages: [nginx"},
+		},
+	})
+	diags = dataSourceCloudInitConfigRead(context.Background(), invalid, nil)
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "part 0: invalid cloud-config YAML")
+}
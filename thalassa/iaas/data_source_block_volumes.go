@@ -0,0 +1,92 @@
+package iaas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	iaas "github.com/thalassa-cloud/client-go/iaas"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+func DataSourceBlockVolumes() *schema.Resource {
+	s := listFilterSchema("block volumes", false)
+	s["block_volumes"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Block volumes matching the filters.",
+		Elem: listedObjectElem("block volume", map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Region of the block volume.",
+			},
+			"volume_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the volume type of the block volume.",
+			},
+			"size_gb": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Size of the block volume in GB.",
+			},
+		}),
+	}
+	return &schema.Resource{
+		Description: "List the block volumes of a project, optionally filtered by labels and region",
+		ReadContext: dataSourceBlockVolumesRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceBlockVolumesRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	volumes, err := client.IaaS().ListVolumes(ctx, &iaas.ListVolumesRequest{
+		Filters: listFilters(d),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("listing block volumes: %w", err))
+	}
+
+	result := make([]map[string]any, 0, len(volumes))
+	for _, volume := range volumes {
+		if !matchesListFilters(d, volume.Labels, "") {
+			continue
+		}
+		item := map[string]any{
+			"id":          volume.Identity,
+			"name":        volume.Name,
+			"slug":        volume.Slug,
+			"description": volume.Description,
+			"labels":      volume.Labels,
+			"annotations": volume.Annotations,
+			"status":      volume.Status,
+			"region":      convert.RegionStateValue(volume.Region, ""),
+			"volume_type": "",
+			"size_gb":     volume.Size,
+		}
+		if volume.VolumeType != nil {
+			item["volume_type"] = volume.VolumeType.Name
+		}
+		result = append(result, item)
+	}
+
+	id, err := listDataSourceID(d, m, "block_volumes")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
+	if err := d.Set("block_volumes", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package iaas
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validate "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceCloudInitConfig() *schema.Resource {
+	return &schema.Resource{
+		Description: "Compose cloud-config YAML, shell scripts and include files into a MIME multipart cloud-init document, which can be passed to the `cloud_init` of a virtual machine instance. Cloud-config parts are validated when the data source is read, so YAML errors are reported at plan time.",
+		ReadContext: dataSourceCloudInitConfigRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"part": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Parts of the document, in the order cloud-init processes them",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      cloudInitContentTypeCloudConfig,
+							ValidateFunc: validate.StringInSlice(cloudInitContentTypes, false),
+							Description:  fmt.Sprintf("MIME type of the part. Defaults to `%s`.", cloudInitContentTypeCloudConfig),
+						},
+						"content": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Content of the part",
+						},
+						"filename": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "File name of the part, shown by cloud-init in its logs",
+						},
+						"merge_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "How cloud-init merges the part with the preceding parts, e.g. `list(append)+dict(recurse_array)+str()`",
+						},
+					},
+				},
+			},
+			"boundary": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultCloudInitBoundary,
+				Description: fmt.Sprintf("Boundary between the parts of the document. Defaults to `%s`.", defaultCloudInitBoundary),
+			},
+			"rendered": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The rendered multipart document",
+			},
+		},
+	}
+}
+
+func dataSourceCloudInitConfigRead(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+	var parts []cloudInitPart
+	for i, raw := range d.Get("part").([]any) {
+		p := raw.(map[string]any)
+		part := cloudInitPart{
+			contentType: p["content_type"].(string),
+			content:     p["content"].(string),
+			filename:    p["filename"].(string),
+			mergeType:   p["merge_type"].(string),
+		}
+		if part.contentType == cloudInitContentTypeCloudConfig {
+			if err := validateCloudConfig(part.content); err != nil {
+				return diag.Errorf("part %d: %s", i, err)
+			}
+		}
+		parts = append(parts, part)
+	}
+
+	rendered, err := renderCloudInitMultipart(parts, d.Get("boundary").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	hash := sha256.Sum256([]byte(rendered))
+	d.SetId(hex.EncodeToString(hash[:]))
+	_ = d.Set("rendered", rendered)
+	return nil
+}
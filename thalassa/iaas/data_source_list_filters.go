@@ -0,0 +1,159 @@
+package iaas
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/thalassa-cloud/client-go/filters"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+// listFilterSchema returns the arguments of plural data sources that are passed to the API as list filters.
+// The vpc_id filter is only added for objects that belong to a VPC.
+func listFilterSchema(objects string, vpcFilter bool) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"organisation_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Organisation ID. Defaults to the provider organisation.",
+		},
+		"project_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Project ID. Defaults to the provider project.",
+		},
+		"label_selector": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: fmt.Sprintf("Only return %s that have all of these labels.", objects),
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: fmt.Sprintf("Only return %s in this region. Provide the identity or slug of the region.", objects),
+		},
+	}
+	if vpcFilter {
+		s["vpc_id"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: fmt.Sprintf("Only return %s in this VPC.", objects),
+		}
+	}
+	return s
+}
+
+// listFilters returns the API list filters for the filter arguments of a plural data source.
+func listFilters(d *schema.ResourceData) filters.Filters {
+	requestFilters := filters.Filters{}
+	if region, ok := d.GetOk("region"); ok {
+		requestFilters.AddFilter(&filters.FilterKeyValue{
+			Key:   filters.FilterRegion,
+			Value: region.(string),
+		})
+	}
+	if vpcID, ok := d.GetOk("vpc_id"); ok {
+		requestFilters.AddFilter(&filters.FilterKeyValue{
+			Key:   filters.FilterVpcIdentity,
+			Value: vpcID.(string),
+		})
+	}
+	if labels := convert.ConvertToMap(d.Get("label_selector")); len(labels) > 0 {
+		requestFilters.AddFilter(&filters.LabelFilter{
+			MatchLabels: labels,
+		})
+	}
+	return requestFilters
+}
+
+// listDataSourceID returns the ID of a plural data source listing objects: the organisation and project it lists in,
+// and a hash of the filter arguments, so data sources with a different scope or different filters get different IDs.
+func listDataSourceID(d *schema.ResourceData, m any, objects string) (string, error) {
+	organisation, project, err := provider.GetScope(provider.GetProvider(m), d)
+	if err != nil {
+		return "", err
+	}
+
+	parts := []string{}
+	for _, key := range []string{"region", "vpc_id", "label_selector"} {
+		if v, ok := d.GetOk(key); ok {
+			parts = append(parts, fmt.Sprintf("%s=%v", key, v))
+		}
+	}
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\n")))
+
+	scope := []string{organisation}
+	if project != "" {
+		scope = append(scope, project)
+	}
+	return strings.Join(append(scope, objects, hex.EncodeToString(sum[:8])), "/"), nil
+}
+
+// matchesListFilters re-applies the label selector and VPC filter of a plural data source in case the API ignores
+// them. An empty vpcID skips the VPC check, for objects that do not report their VPC.
+func matchesListFilters(d *schema.ResourceData, labels map[string]string, vpcID string) bool {
+	for k, v := range convert.ConvertToMap(d.Get("label_selector")) {
+		if labels[k] != v {
+			return false
+		}
+	}
+	if want, ok := d.GetOk("vpc_id"); ok && vpcID != "" && vpcID != want.(string) {
+		return false
+	}
+	return true
+}
+
+// listedObjectElem returns the element schema of the objects returned by a plural data source: the attributes that
+// all objects share, plus the given object specific attributes.
+func listedObjectElem(object string, attributes map[string]*schema.Schema) *schema.Resource {
+	s := map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Identity of the %s.", object),
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Name of the %s.", object),
+		},
+		"slug": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Slug of the %s.", object),
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Description of the %s.", object),
+		},
+		"labels": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: fmt.Sprintf("Labels of the %s.", object),
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"annotations": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: fmt.Sprintf("Annotations of the %s.", object),
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Status of the %s.", object),
+		},
+	}
+	for name, attribute := range attributes {
+		s[name] = attribute
+	}
+	return &schema.Resource{Schema: s}
+}
@@ -0,0 +1,107 @@
+package iaas
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/thalassa-cloud/client-go/filters"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+func TestListFilters(t *testing.T) {
+	t.Parallel()
+
+	d := schema.TestResourceDataRaw(t, listFilterSchema("virtual machine instances", true), map[string]any{
+		"region":         "nl-01",
+		"vpc_id":         "vpc-1",
+		"label_selector": map[string]any{"tier": "web"},
+	})
+
+	assert.Equal(t, filters.Filters{
+		&filters.FilterKeyValue{Key: filters.FilterRegion, Value: "nl-01"},
+		&filters.FilterKeyValue{Key: filters.FilterVpcIdentity, Value: "vpc-1"},
+		&filters.LabelFilter{MatchLabels: map[string]string{"tier": "web"}},
+	}, listFilters(d))
+
+	empty := schema.TestResourceDataRaw(t, listFilterSchema("VPCs", false), map[string]any{})
+	assert.Empty(t, listFilters(empty))
+}
+
+func TestListDataSourceID(t *testing.T) {
+	t.Parallel()
+
+	m := provider.ConfiguredProvider{Organisation: "org-provider"}
+	id := func(raw map[string]any) string {
+		d := schema.TestResourceDataRaw(t, listFilterSchema("VPCs", false), raw)
+		id, err := listDataSourceID(d, m, "vpcs")
+		assert.NoError(t, err)
+		return id
+	}
+
+	unfiltered := id(map[string]any{})
+	assert.Regexp(t, `^org-provider/vpcs/[0-9a-f]{16}$`, unfiltered)
+	assert.Regexp(t, `^org-1/prj-1/vpcs/[0-9a-f]{16}$`, id(map[string]any{"organisation_id": "org-1", "project_id": "prj-1"}))
+
+	// the same filters give the same ID, different filters a different one
+	filtered := id(map[string]any{"region": "nl-01", "label_selector": map[string]any{"tier": "web", "env": "prod"}})
+	assert.Equal(t, filtered, id(map[string]any{"label_selector": map[string]any{"env": "prod", "tier": "web"}, "region": "nl-01"}))
+	assert.NotEqual(t, unfiltered, filtered)
+	assert.NotEqual(t, filtered, id(map[string]any{"region": "nl-01", "label_selector": map[string]any{"tier": "web"}}))
+}
+
+func TestMatchesListFilters(t *testing.T) {
+	t.Parallel()
+
+	d := schema.TestResourceDataRaw(t, listFilterSchema("virtual machine instances", true), map[string]any{
+		"vpc_id":         "vpc-1",
+		"label_selector": map[string]any{"tier": "web"},
+	})
+
+	tests := []struct {
+		name     string
+		labels   map[string]string
+		vpcID    string
+		expected bool
+	}{
+		{
+			name:     "matching labels and VPC",
+			labels:   map[string]string{"tier": "web", "env": "prod"},
+			vpcID:    "vpc-1",
+			expected: true,
+		},
+		{
+			name:     "different label value",
+			labels:   map[string]string{"tier": "db"},
+			vpcID:    "vpc-1",
+			expected: false,
+		},
+		{
+			name:     "missing label",
+			labels:   nil,
+			vpcID:    "vpc-1",
+			expected: false,
+		},
+		{
+			name:     "different VPC",
+			labels:   map[string]string{"tier": "web"},
+			vpcID:    "vpc-2",
+			expected: false,
+		},
+		{
+			name:     "unknown VPC is not filtered",
+			labels:   map[string]string{"tier": "web"},
+			vpcID:    "",
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, matchesListFilters(d, tt.labels, tt.vpcID))
+		})
+	}
+}
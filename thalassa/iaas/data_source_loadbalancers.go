@@ -0,0 +1,101 @@
+package iaas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	iaas "github.com/thalassa-cloud/client-go/iaas"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+func DataSourceLoadBalancers() *schema.Resource {
+	s := listFilterSchema("load balancers", true)
+	s["loadbalancers"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Load balancers matching the filters.",
+		Elem: listedObjectElem("load balancer", map[string]*schema.Schema{
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identity of the VPC of the load balancer.",
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identity of the subnet of the load balancer.",
+			},
+			"hostname": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Hostname of the load balancer.",
+			},
+			"external_ip_addresses": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "External IP addresses of the load balancer.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"internal_ip_addresses": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Internal IP addresses of the load balancer.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		}),
+	}
+	return &schema.Resource{
+		Description: "List the load balancers of a project, optionally filtered by labels, region and VPC",
+		ReadContext: dataSourceLoadBalancersRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceLoadBalancersRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	loadbalancers, err := client.IaaS().ListLoadbalancers(ctx, &iaas.ListLoadbalancersRequest{
+		Filters: listFilters(d),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("listing load balancers: %w", err))
+	}
+
+	result := make([]map[string]any, 0, len(loadbalancers))
+	for _, loadbalancer := range loadbalancers {
+		if !matchesListFilters(d, loadbalancer.Labels, loadbalancer.VpcIdentity) {
+			continue
+		}
+		result = append(result, map[string]any{
+			"id":                    loadbalancer.Identity,
+			"name":                  loadbalancer.Name,
+			"slug":                  loadbalancer.Slug,
+			"description":           loadbalancer.Description,
+			"labels":                loadbalancer.Labels,
+			"annotations":           loadbalancer.Annotations,
+			"status":                loadbalancer.Status,
+			"vpc_id":                loadbalancer.VpcIdentity,
+			"subnet_id":             loadbalancer.SubnetIdentity,
+			"hostname":              loadbalancer.Hostname,
+			"external_ip_addresses": loadbalancer.ExternalIpAddresses,
+			"internal_ip_addresses": loadbalancer.InternalIpAddresses,
+		})
+	}
+
+	id, err := listDataSourceID(d, m, "loadbalancers")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
+	if err := d.Set("loadbalancers", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
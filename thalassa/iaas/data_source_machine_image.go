@@ -32,6 +32,11 @@ func DataSourceMachineImage() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation of the Machine Image. If not provided, the organisation configured in the Terraform provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"slug": {
 				Type:        schema.TypeString,
 				Optional:    true,
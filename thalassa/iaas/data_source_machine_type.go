@@ -31,6 +31,11 @@ func DataSourceMachineType() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation of the Machine Type. If not provided, the organisation of the (Terraform) provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"slug": {
 				Type:        schema.TypeString,
 				Optional:    true,
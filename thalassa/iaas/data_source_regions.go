@@ -24,6 +24,11 @@ func DataSourceRegions() *schema.Resource {
 				ForceNew:    true,
 				Description: "The organisation to get the regions for. If not provided, the current organisation will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"regions": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -0,0 +1,100 @@
+package iaas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	iaas "github.com/thalassa-cloud/client-go/iaas"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+func DataSourceReservedIPs() *schema.Resource {
+	s := listFilterSchema("reserved IPs", false)
+	s["reserved_ips"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Reserved IPs matching the filters.",
+		Elem: listedObjectElem("reserved IP", map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Region of the reserved IP.",
+			},
+			"ipv4_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IPv4 address of the reserved IP.",
+			},
+			"ipv6_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IPv6 address of the reserved IP.",
+			},
+			"attached_to_resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the resource the reserved IP is attached to, if any.",
+			},
+			"attached_to_resource_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identity of the resource the reserved IP is attached to, if any.",
+			},
+		}),
+	}
+	return &schema.Resource{
+		Description: "List the reserved IPs of a project, optionally filtered by labels and region",
+		ReadContext: dataSourceReservedIPsRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceReservedIPsRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	reservedIPs, err := client.IaaS().ListReservedIPs(ctx, &iaas.ListReservedIPsRequest{
+		Filters: listFilters(d),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("listing reserved IPs: %w", err))
+	}
+
+	result := make([]map[string]any, 0, len(reservedIPs))
+	for _, reservedIP := range reservedIPs {
+		if !matchesListFilters(d, reservedIP.Labels, "") {
+			continue
+		}
+		result = append(result, map[string]any{
+			"id":                        reservedIP.Identity,
+			"name":                      reservedIP.Name,
+			"slug":                      reservedIP.Slug,
+			"description":               reservedIP.Description,
+			"labels":                    reservedIP.Labels,
+			"annotations":               reservedIP.Annotations,
+			"status":                    string(reservedIP.Status),
+			"region":                    convert.RegionStateValue(reservedIP.Region, ""),
+			"ipv4_address":              reservedIP.IPv4Address,
+			"ipv6_address":              reservedIP.IPv6Address,
+			"attached_to_resource_type": string(reservedIP.AttachedToResourceType),
+			"attached_to_resource_id":   reservedIP.AttachedToResourceIdentity,
+		})
+	}
+
+	id, err := listDataSourceID(d, m, "reserved_ips")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
+	if err := d.Set("reserved_ips", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -34,6 +34,11 @@ func DataSourceSecurityGroup() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation of the Security Group. If not provided, the organisation of the (Terraform) provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"vpc_identity": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -0,0 +1,85 @@
+package iaas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	iaas "github.com/thalassa-cloud/client-go/iaas"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+func DataSourceSecurityGroups() *schema.Resource {
+	s := listFilterSchema("security groups", true)
+	s["security_groups"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Security groups matching the filters.",
+		Elem: listedObjectElem("security group", map[string]*schema.Schema{
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identity of the VPC of the security group.",
+			},
+			"allow_same_group_traffic": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the security group allows traffic between members of the group.",
+			},
+		}),
+	}
+	return &schema.Resource{
+		Description: "List the security groups of a project, optionally filtered by labels, region and VPC",
+		ReadContext: dataSourceSecurityGroupsRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceSecurityGroupsRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	securityGroups, err := client.IaaS().ListSecurityGroups(ctx, &iaas.ListSecurityGroupsRequest{
+		Filters: listFilters(d),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("listing security groups: %w", err))
+	}
+
+	result := make([]map[string]any, 0, len(securityGroups))
+	for _, securityGroup := range securityGroups {
+		vpcID := ""
+		if securityGroup.Vpc != nil {
+			vpcID = securityGroup.Vpc.Identity
+		}
+		if !matchesListFilters(d, securityGroup.Labels, vpcID) {
+			continue
+		}
+		result = append(result, map[string]any{
+			"id":                       securityGroup.Identity,
+			"name":                     securityGroup.Name,
+			"slug":                     securityGroup.Slug,
+			"description":              securityGroup.Description,
+			"labels":                   securityGroup.Labels,
+			"annotations":              securityGroup.Annotations,
+			"status":                   string(securityGroup.Status),
+			"vpc_id":                   vpcID,
+			"allow_same_group_traffic": securityGroup.AllowSameGroupTraffic,
+		})
+	}
+
+	id, err := listDataSourceID(d, m, "security_groups")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
+	if err := d.Set("security_groups", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -39,6 +39,11 @@ func DataSourceSnapshotPolicy() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation of the Snapshot Policy. If not provided, the organisation of the (Terraform) provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"slug": {
 				Type:        schema.TypeString,
 				Computed:    true,
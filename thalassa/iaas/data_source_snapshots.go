@@ -0,0 +1,95 @@
+package iaas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	iaas "github.com/thalassa-cloud/client-go/iaas"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+func DataSourceSnapshots() *schema.Resource {
+	s := listFilterSchema("snapshots", false)
+	s["snapshots"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Snapshots matching the filters.",
+		Elem: listedObjectElem("snapshot", map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Region of the snapshot.",
+			},
+			"source_volume_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identity of the volume the snapshot was created from.",
+			},
+			"size_gb": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Size of the snapshot in GB. Zero until the snapshot is complete.",
+			},
+		}),
+	}
+	return &schema.Resource{
+		Description: "List the snapshots of a project, optionally filtered by labels and region",
+		ReadContext: dataSourceSnapshotsRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceSnapshotsRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	snapshots, err := client.IaaS().ListSnapshots(ctx, &iaas.ListSnapshotsRequest{
+		Filters: listFilters(d),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("listing snapshots: %w", err))
+	}
+
+	result := make([]map[string]any, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		if !matchesListFilters(d, snapshot.Labels, "") {
+			continue
+		}
+		item := map[string]any{
+			"id":               snapshot.Identity,
+			"name":             snapshot.Name,
+			"slug":             snapshot.Slug,
+			"description":      snapshot.Description,
+			"labels":           snapshot.Labels,
+			"annotations":      snapshot.Annotations,
+			"status":           string(snapshot.Status),
+			"region":           convert.RegionStateValue(snapshot.Region, ""),
+			"source_volume_id": "",
+			"size_gb":          0,
+		}
+		if snapshot.SourceVolumeId != nil {
+			item["source_volume_id"] = *snapshot.SourceVolumeId
+		}
+		if snapshot.SizeGB != nil {
+			item["size_gb"] = *snapshot.SizeGB
+		}
+		result = append(result, item)
+	}
+
+	id, err := listDataSourceID(d, m, "snapshots")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
+	if err := d.Set("snapshots", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
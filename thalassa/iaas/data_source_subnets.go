@@ -0,0 +1,87 @@
+package iaas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	iaas "github.com/thalassa-cloud/client-go/iaas"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+func DataSourceSubnets() *schema.Resource {
+	s := listFilterSchema("subnets", true)
+	s["subnets"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Subnets matching the filters.",
+		Elem: listedObjectElem("subnet", map[string]*schema.Schema{
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identity of the VPC of the subnet.",
+			},
+			"cidr": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "CIDR block of the subnet.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the subnet.",
+			},
+		}),
+	}
+	return &schema.Resource{
+		Description: "List the subnets of a project, optionally filtered by labels, region and VPC",
+		ReadContext: dataSourceSubnetsRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceSubnetsRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	subnets, err := client.IaaS().ListSubnets(ctx, &iaas.ListSubnetsRequest{
+		Filters: listFilters(d),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("listing subnets: %w", err))
+	}
+
+	result := make([]map[string]any, 0, len(subnets))
+	for _, subnet := range subnets {
+		if !matchesListFilters(d, subnet.Labels, subnet.VpcIdentity) {
+			continue
+		}
+		result = append(result, map[string]any{
+			"id":          subnet.Identity,
+			"name":        subnet.Name,
+			"slug":        subnet.Slug,
+			"description": subnet.Description,
+			"labels":      subnet.Labels,
+			"annotations": subnet.Annotations,
+			"status":      string(subnet.Status),
+			"vpc_id":      subnet.VpcIdentity,
+			"cidr":        subnet.Cidr,
+			"type":        string(subnet.Type),
+		})
+	}
+
+	id, err := listDataSourceID(d, m, "subnets")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
+	if err := d.Set("subnets", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package iaas
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validate "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tcclient "github.com/thalassa-cloud/client-go/pkg/client"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+func DataSourceVirtualMachineConsoleOutput() *schema.Resource {
+	return &schema.Resource{
+		Description: "Capture the serial console output of a virtual machine instance, for example to debug cloud-init. The data source attaches to the console and returns the output it receives, including the output the console buffered, until the byte or time limit is reached.",
+		ReadContext: dataSourceVirtualMachineConsoleOutputRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"machine_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the virtual machine instance",
+			},
+			"organisation_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reference to the Organisation of the virtual machine instance. If not provided, the organisation of the (Terraform) provider will be used.",
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
+			"max_bytes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultConsoleMaxBytes,
+				ValidateFunc: validate.IntBetween(1, 1024*1024),
+				Description:  fmt.Sprintf("Maximum number of bytes of console output to capture. Defaults to %d.", defaultConsoleMaxBytes),
+			},
+			"capture_duration": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultConsoleCaptureDuration.String(),
+				Description: fmt.Sprintf("How long to capture console output, as a duration (e.g. `10s`). Defaults to `%s`.", defaultConsoleCaptureDuration),
+			},
+			"output": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The captured console output",
+			},
+			"truncated": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the output was cut off at max_bytes",
+			},
+		},
+	}
+}
+
+func dataSourceVirtualMachineConsoleOutputRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	duration, err := time.ParseDuration(d.Get("capture_duration").(string))
+	if err != nil || duration <= 0 {
+		return diag.Errorf("invalid capture_duration %q: must be a positive duration such as 10s", d.Get("capture_duration").(string))
+	}
+
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	machineID := d.Get("machine_id").(string)
+	machine, err := client.IaaS().GetMachine(ctx, machineID)
+	if err != nil && !tcclient.IsNotFound(err) {
+		return diag.FromErr(fmt.Errorf("failed to get virtual machine instance: %w", err))
+	}
+	if machine == nil {
+		return diag.Errorf("virtual machine instance %q not found", machineID)
+	}
+
+	maxBytes := d.Get("max_bytes").(int)
+	output, truncated, err := captureMachineConsole(ctx, client, machine.Identity, maxBytes, duration)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d/%s", machine.Identity, maxBytes, duration))
+	if err := d.Set("output", output); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("truncated", truncated); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
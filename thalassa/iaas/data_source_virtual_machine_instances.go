@@ -0,0 +1,117 @@
+package iaas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	iaas "github.com/thalassa-cloud/client-go/iaas"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+func DataSourceVirtualMachineInstances() *schema.Resource {
+	s := listFilterSchema("virtual machine instances", true)
+	s["virtual_machine_instances"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Virtual machine instances matching the filters.",
+		Elem: listedObjectElem("virtual machine instance", map[string]*schema.Schema{
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identity of the VPC of the virtual machine instance.",
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identity of the subnet of the virtual machine instance.",
+			},
+			"machine_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Slug of the machine type of the virtual machine instance.",
+			},
+			"availability_zone": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Availability zone of the virtual machine instance.",
+			},
+			"ip_addresses": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "IP addresses of the virtual machine instance.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		}),
+	}
+	return &schema.Resource{
+		Description: "List the virtual machine instances of a project, optionally filtered by labels, region and VPC",
+		ReadContext: dataSourceVirtualMachineInstancesRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceVirtualMachineInstancesRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	machines, err := client.IaaS().ListMachines(ctx, &iaas.ListMachinesRequest{
+		Filters: listFilters(d),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("listing virtual machine instances: %w", err))
+	}
+
+	result := make([]map[string]any, 0, len(machines))
+	for _, machine := range machines {
+		vpcID := ""
+		if machine.Vpc != nil {
+			vpcID = machine.Vpc.Identity
+		}
+		if !matchesListFilters(d, machine.Labels, vpcID) {
+			continue
+		}
+		item := map[string]any{
+			"id":                machine.Identity,
+			"name":              machine.Name,
+			"slug":              machine.Slug,
+			"description":       "",
+			"labels":            machine.Labels,
+			"annotations":       machine.Annotations,
+			"status":            machine.Status.Status,
+			"vpc_id":            vpcID,
+			"subnet_id":         "",
+			"machine_type":      "",
+			"availability_zone": "",
+			"ip_addresses":      getIPAddresses(&machine),
+		}
+		if machine.Description != nil {
+			item["description"] = *machine.Description
+		}
+		if machine.Subnet != nil {
+			item["subnet_id"] = machine.Subnet.Identity
+		}
+		if machine.MachineType != nil {
+			item["machine_type"] = machine.MachineType.Slug
+		}
+		if machine.AvailabilityZone != nil {
+			item["availability_zone"] = *machine.AvailabilityZone
+		}
+		result = append(result, item)
+	}
+
+	id, err := listDataSourceID(d, m, "virtual_machine_instances")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
+	if err := d.Set("virtual_machine_instances", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -31,6 +31,11 @@ func DataSourceVolumeType() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation of the Volume Type. If not provided, the organisation of the (Terraform) provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"description": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -27,6 +27,11 @@ func DataSourceVpc() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation of the Vpc. If not provided, the organisation of the (Terraform) provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -0,0 +1,83 @@
+package iaas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	iaas "github.com/thalassa-cloud/client-go/iaas"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+func DataSourceVpcs() *schema.Resource {
+	s := listFilterSchema("VPCs", false)
+	s["vpcs"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "VPCs matching the filters.",
+		Elem: listedObjectElem("VPC", map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Region of the VPC.",
+			},
+			"cidrs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "CIDR blocks of the VPC.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		}),
+	}
+	return &schema.Resource{
+		Description: "List the VPCs of a project, optionally filtered by labels and region",
+		ReadContext: dataSourceVpcsRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceVpcsRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vpcs, err := client.IaaS().ListVpcs(ctx, &iaas.ListVpcsRequest{
+		Filters: listFilters(d),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("listing VPCs: %w", err))
+	}
+
+	result := make([]map[string]any, 0, len(vpcs))
+	for _, vpc := range vpcs {
+		if !matchesListFilters(d, vpc.Labels, "") {
+			continue
+		}
+		result = append(result, map[string]any{
+			"id":          vpc.Identity,
+			"name":        vpc.Name,
+			"slug":        vpc.Slug,
+			"description": vpc.Description,
+			"labels":      vpc.Labels,
+			"annotations": vpc.Annotations,
+			"status":      vpc.Status,
+			"region":      convert.RegionStateValue(vpc.CloudRegion, ""),
+			"cidrs":       vpc.CIDRs,
+		})
+	}
+
+	id, err := listDataSourceID(d, m, "vpcs")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
+	if err := d.Set("vpcs", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package iaas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	validate "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var _ function.Function = &subnetCIDRsFunction{}
+
+// NewSubnetCIDRsFunction returns the subnet_cidrs provider function.
+func NewSubnetCIDRsFunction() function.Function {
+	return &subnetCIDRsFunction{}
+}
+
+type subnetCIDRsFunction struct{}
+
+func (f *subnetCIDRsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "subnet_cidrs"
+}
+
+func (f *subnetCIDRsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Plan subnet CIDRs within a VPC CIDR",
+		Description: "Splits a VPC CIDR into consecutive subnet CIDRs, each extending the VPC prefix by newbits. The result can be used as the cidr of thalassa_subnet resources.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "vpc_cidr",
+				Description: "CIDR of the VPC, e.g. 10.0.0.0/16.",
+			},
+			function.Int64Parameter{
+				Name:        "count",
+				Description: "Number of subnet CIDRs to return.",
+			},
+			function.Int64Parameter{
+				Name:        "newbits",
+				Description: "Number of bits to extend the VPC prefix with, e.g. 8 to split a /16 into /24 subnets.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *subnetCIDRsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var vpcCIDR string
+	var count, newbits int64
+	resp.Error = req.Arguments.Get(ctx, &vpcCIDR, &count, &newbits)
+	if resp.Error != nil {
+		return
+	}
+
+	cidrs, err := subnetCIDRs(vpcCIDR, count, newbits)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, cidrs)
+}
+
+// subnetCIDRs returns the first count subnets of vpcCIDR with a prefix newbits longer than the VPC prefix.
+func subnetCIDRs(vpcCIDR string, count, newbits int64) ([]string, error) {
+	if _, errs := validate.IsCIDR(vpcCIDR, "vpc_cidr"); len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	prefix, err := netip.ParsePrefix(vpcCIDR)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vpc_cidr %q: %w", vpcCIDR, err)
+	}
+	prefix = prefix.Masked()
+
+	if count < 1 {
+		return nil, fmt.Errorf("count must be at least 1, got %d", count)
+	}
+	if newbits < 1 {
+		return nil, fmt.Errorf("newbits must be at least 1, got %d", newbits)
+	}
+	addressBits := prefix.Addr().BitLen()
+	subnetBits := prefix.Bits() + int(newbits)
+	if subnetBits > addressBits {
+		return nil, fmt.Errorf("newbits %d extends the /%d prefix of %s beyond /%d", newbits, prefix.Bits(), prefix, addressBits)
+	}
+	available := new(big.Int).Lsh(big.NewInt(1), uint(newbits))
+	if big.NewInt(count).Cmp(available) > 0 {
+		return nil, fmt.Errorf("%s has room for %s subnets of /%d, not %d", prefix, available, subnetBits, count)
+	}
+
+	base := new(big.Int).SetBytes(prefix.Addr().AsSlice())
+	step := new(big.Int).Lsh(big.NewInt(1), uint(addressBits-subnetBits))
+	cidrs := make([]string, 0, count)
+	for i := range count {
+		offset := new(big.Int).Mul(step, big.NewInt(i))
+		address, _ := netip.AddrFromSlice(new(big.Int).Add(base, offset).FillBytes(make([]byte, addressBits/8)))
+		cidrs = append(cidrs, netip.PrefixFrom(address, subnetBits).String())
+	}
+	return cidrs, nil
+}
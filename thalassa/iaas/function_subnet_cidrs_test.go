@@ -0,0 +1,55 @@
+package iaas
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubnetCIDRs(t *testing.T) {
+	tests := []struct {
+		name    string
+		vpcCIDR string
+		count   int64
+		newbits int64
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:    "split a /16 into /24 subnets",
+			vpcCIDR: "10.0.0.0/16",
+			count:   3,
+			newbits: 8,
+			want:    []string{"10.0.0.0/24", "10.0.1.0/24", "10.0.2.0/24"},
+		},
+		{
+			name:    "unmasked VPC CIDR",
+			vpcCIDR: "10.0.12.0/22",
+			count:   2,
+			newbits: 2,
+			want:    []string{"10.0.12.0/24", "10.0.13.0/24"},
+		},
+		{
+			name:    "IPv6",
+			vpcCIDR: "2001:db8::/56",
+			count:   2,
+			newbits: 8,
+			want:    []string{"2001:db8::/64", "2001:db8:0:1::/64"},
+		},
+		{name: "invalid CIDR", vpcCIDR: "10.0.0.0", count: 1, newbits: 8, wantErr: true},
+		{name: "too many subnets", vpcCIDR: "10.0.0.0/16", count: 5, newbits: 2, wantErr: true},
+		{name: "prefix too long", vpcCIDR: "10.0.0.0/28", count: 1, newbits: 8, wantErr: true},
+		{name: "no subnets", vpcCIDR: "10.0.0.0/16", count: 0, newbits: 8, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := subnetCIDRs(tt.vpcCIDR, tt.count, tt.newbits)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package iaas
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+
+	"github.com/thalassa-cloud/client-go/filters"
+	iaas "github.com/thalassa-cloud/client-go/iaas"
+	"github.com/thalassa-cloud/client-go/thalassa"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+// NewVpcListResource returns the thalassa_vpc list resource.
+func NewVpcListResource() list.ListResource {
+	return &provider.SDKListResource{
+		TypeName:    "vpc",
+		Description: "VPCs",
+		Resource:    resourceVpc,
+		Regional:    true,
+		ListObjects: func(ctx context.Context, client thalassa.Client, requestFilters []filters.Filter) ([]provider.ListedObject, error) {
+			vpcs, err := client.IaaS().ListVpcs(ctx, &iaas.ListVpcsRequest{Filters: requestFilters})
+			return provider.ListedObjects(vpcs, func(vpc iaas.Vpc) provider.ListedObject {
+				return provider.ListedObject{ID: vpc.Identity, Name: vpc.Name}
+			}), err
+		},
+	}
+}
+
+// NewSubnetListResource returns the thalassa_subnet list resource.
+func NewSubnetListResource() list.ListResource {
+	return &provider.SDKListResource{
+		TypeName:    "subnet",
+		Description: "subnets",
+		Resource:    resourceSubnet,
+		Regional:    true,
+		ListObjects: func(ctx context.Context, client thalassa.Client, requestFilters []filters.Filter) ([]provider.ListedObject, error) {
+			subnets, err := client.IaaS().ListSubnets(ctx, &iaas.ListSubnetsRequest{Filters: requestFilters})
+			return provider.ListedObjects(subnets, func(subnet iaas.Subnet) provider.ListedObject {
+				return provider.ListedObject{ID: subnet.Identity, Name: subnet.Name}
+			}), err
+		},
+	}
+}
+
+// NewSecurityGroupListResource returns the thalassa_security_group list resource.
+func NewSecurityGroupListResource() list.ListResource {
+	return &provider.SDKListResource{
+		TypeName:    "security_group",
+		Description: "security groups",
+		Resource:    ResourceSecurityGroup,
+		Regional:    true,
+		ListObjects: func(ctx context.Context, client thalassa.Client, requestFilters []filters.Filter) ([]provider.ListedObject, error) {
+			securityGroups, err := client.IaaS().ListSecurityGroups(ctx, &iaas.ListSecurityGroupsRequest{Filters: requestFilters})
+			return provider.ListedObjects(securityGroups, func(securityGroup iaas.SecurityGroup) provider.ListedObject {
+				return provider.ListedObject{ID: securityGroup.Identity, Name: securityGroup.Name}
+			}), err
+		},
+	}
+}
+
+// NewRouteTableListResource returns the thalassa_route_table list resource.
+func NewRouteTableListResource() list.ListResource {
+	return &provider.SDKListResource{
+		TypeName:    "route_table",
+		Description: "route tables",
+		Resource:    resourceRouteTable,
+		Regional:    true,
+		ListObjects: func(ctx context.Context, client thalassa.Client, requestFilters []filters.Filter) ([]provider.ListedObject, error) {
+			routeTables, err := client.IaaS().ListRouteTables(ctx, &iaas.ListRouteTablesRequest{Filters: requestFilters})
+			return provider.ListedObjects(routeTables, func(routeTable iaas.RouteTable) provider.ListedObject {
+				return provider.ListedObject{ID: routeTable.Identity, Name: routeTable.Name}
+			}), err
+		},
+	}
+}
+
+// NewNatGatewayListResource returns the thalassa_natgateway list resource.
+func NewNatGatewayListResource() list.ListResource {
+	return &provider.SDKListResource{
+		TypeName:    "natgateway",
+		Description: "NAT gateways",
+		Resource:    resourceNatGateway,
+		Regional:    true,
+		ListObjects: func(ctx context.Context, client thalassa.Client, requestFilters []filters.Filter) ([]provider.ListedObject, error) {
+			natGateways, err := client.IaaS().ListNatGateways(ctx, &iaas.ListNatGatewaysRequest{Filters: requestFilters})
+			return provider.ListedObjects(natGateways, func(natGateway iaas.VpcNatGateway) provider.ListedObject {
+				return provider.ListedObject{ID: natGateway.Identity, Name: natGateway.Name}
+			}), err
+		},
+	}
+}
+
+// NewLoadBalancerListResource returns the thalassa_loadbalancer list resource.
+func NewLoadBalancerListResource() list.ListResource {
+	return &provider.SDKListResource{
+		TypeName:    "loadbalancer",
+		Description: "load balancers",
+		Resource:    resourceLoadBalancer,
+		Regional:    true,
+		ListObjects: func(ctx context.Context, client thalassa.Client, requestFilters []filters.Filter) ([]provider.ListedObject, error) {
+			loadbalancers, err := client.IaaS().ListLoadbalancers(ctx, &iaas.ListLoadbalancersRequest{Filters: requestFilters})
+			return provider.ListedObjects(loadbalancers, func(loadbalancer iaas.VpcLoadbalancer) provider.ListedObject {
+				return provider.ListedObject{ID: loadbalancer.Identity, Name: loadbalancer.Name}
+			}), err
+		},
+	}
+}
+
+// NewVirtualMachineInstanceListResource returns the thalassa_virtual_machine_instance list resource.
+func NewVirtualMachineInstanceListResource() list.ListResource {
+	return &provider.SDKListResource{
+		TypeName:    "virtual_machine_instance",
+		Description: "virtual machine instances",
+		Resource:    resourceVirtualMachineInstance,
+		Regional:    true,
+		ListObjects: func(ctx context.Context, client thalassa.Client, requestFilters []filters.Filter) ([]provider.ListedObject, error) {
+			machines, err := client.IaaS().ListMachines(ctx, &iaas.ListMachinesRequest{Filters: requestFilters})
+			return provider.ListedObjects(machines, func(machine iaas.Machine) provider.ListedObject {
+				return provider.ListedObject{ID: machine.Identity, Name: machine.Name}
+			}), err
+		},
+	}
+}
+
+// NewBlockVolumeListResource returns the thalassa_block_volume list resource.
+func NewBlockVolumeListResource() list.ListResource {
+	return &provider.SDKListResource{
+		TypeName:    "block_volume",
+		Description: "block volumes",
+		Resource:    resourceBlockVolume,
+		Regional:    true,
+		ListObjects: func(ctx context.Context, client thalassa.Client, requestFilters []filters.Filter) ([]provider.ListedObject, error) {
+			volumes, err := client.IaaS().ListVolumes(ctx, &iaas.ListVolumesRequest{Filters: requestFilters})
+			return provider.ListedObjects(volumes, func(volume iaas.Volume) provider.ListedObject {
+				return provider.ListedObject{ID: volume.Identity, Name: volume.Name}
+			}), err
+		},
+	}
+}
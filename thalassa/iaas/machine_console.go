@@ -0,0 +1,113 @@
+package iaas
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/thalassa-cloud/client-go/thalassa"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/waiter"
+)
+
+const (
+	defaultConsoleMaxBytes        = 64 * 1024
+	defaultConsoleCaptureDuration = 5 * time.Second
+	// consoleFailureLines is the number of console lines included in the diagnostic of a failed machine.
+	consoleFailureLines = 30
+)
+
+// captureMachineConsole attaches to the console of the machine and captures its serial output, including the output
+// the console buffered before it was attached, until maxBytes were read or the duration passed. It reports whether
+// the output was cut off at maxBytes.
+func captureMachineConsole(ctx context.Context, client thalassa.Client, machineID string, maxBytes int, duration time.Duration) (string, bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	conn, err := client.IaaS().MachineConsole(ctx, machineID)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to attach to the console of virtual machine instance %q: %w", machineID, err)
+	}
+	defer conn.Close()
+
+	return readConsoleOutput(ctx, conn, maxBytes)
+}
+
+// readConsoleOutput reads console messages until maxBytes were read, the context is done or the console is closed.
+// The end of the capture is not an error; the output read until then is returned.
+func readConsoleOutput(ctx context.Context, conn *websocket.Conn, maxBytes int) (string, bool, error) {
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetReadDeadline(deadline)
+	}
+	stop := context.AfterFunc(ctx, func() { _ = conn.SetReadDeadline(time.Now()) })
+	defer stop()
+
+	var output bytes.Buffer
+	truncated := false
+	for output.Len() < maxBytes {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			var netErr net.Error
+			if (errors.As(err, &netErr) && netErr.Timeout()) || websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				break
+			}
+			return "", false, fmt.Errorf("failed to read console output: %w", err)
+		}
+		if remaining := maxBytes - output.Len(); len(message) > remaining {
+			message = message[:remaining]
+			truncated = true
+		}
+		output.Write(message)
+	}
+	// serial output is not necessarily valid UTF-8, which Terraform strings must be
+	return strings.ToValidUTF8(output.String(), "�"), truncated, nil
+}
+
+// lastLines returns the last n lines of the output.
+func lastLines(output string, n int) string {
+	lines := strings.Split(strings.TrimRight(strings.ReplaceAll(output, "\r\n", "\n"), "\n"), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// machineWaitDiagnostics returns the diagnostics of a failed wait for a virtual machine instance. When
+// capture_console_on_failure is set and the machine entered a failed state, the last lines of its console output are
+// added to the diagnostic, which usually shows why cloud-init or the boot failed.
+func machineWaitDiagnostics(ctx context.Context, m any, d *schema.ResourceData, machineID string, err error) diag.Diagnostics {
+	var waitErr *waiter.Error
+	if !d.Get("capture_console_on_failure").(bool) || !errors.As(err, &waitErr) || waitErr.Timeout {
+		return diag.FromErr(err)
+	}
+
+	client, consoleErr := provider.GetClient(provider.GetProvider(m), d)
+	output := ""
+	if consoleErr == nil {
+		output, _, consoleErr = captureMachineConsole(ctx, client, machineID, defaultConsoleMaxBytes, defaultConsoleCaptureDuration)
+	}
+
+	detail := ""
+	switch {
+	case consoleErr != nil:
+		detail = fmt.Sprintf("The console output could not be captured: %s", consoleErr)
+	case strings.TrimSpace(output) == "":
+		detail = "The console of the virtual machine instance did not return any output."
+	default:
+		detail = fmt.Sprintf("Last console output of the virtual machine instance:\n\n%s", lastLines(output, consoleFailureLines))
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  err.Error(),
+		Detail:   detail,
+	}}
+}
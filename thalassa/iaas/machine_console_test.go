@@ -0,0 +1,89 @@
+package iaas
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+// consoleServer returns a websocket server that sends the messages and then keeps the connection open.
+func consoleServer(t *testing.T, messages ...string) *httptest.Server {
+	upgrader := websocket.Upgrader{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer conn.Close()
+		for _, message := range messages {
+			_ = conn.WriteMessage(websocket.BinaryMessage, []byte(message))
+		}
+		// wait for the client to hang up
+		_, _, _ = conn.ReadMessage()
+	}))
+}
+
+func dialConsole(t *testing.T, server *httptest.Server) *websocket.Conn {
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	assert.NoError(t, err)
+	return conn
+}
+
+func TestReadConsoleOutput(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reads until the time limit", func(t *testing.T) {
+		t.Parallel()
+		server := consoleServer(t, "[  OK  ] Reached target Cloud-init.\r\n", "ubuntu login: ")
+		defer server.Close()
+		conn := dialConsole(t, server)
+		defer conn.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		output, truncated, err := readConsoleOutput(ctx, conn, defaultConsoleMaxBytes)
+		assert.NoError(t, err)
+		assert.False(t, truncated)
+		assert.Equal(t, "[  OK  ] Reached target Cloud-init.\r\nubuntu login: ", output)
+	})
+
+	t.Run("stops at the byte limit", func(t *testing.T) {
+		t.Parallel()
+		server := consoleServer(t, "0123456789", "abcdef")
+		defer server.Close()
+		conn := dialConsole(t, server)
+		defer conn.Close()
+
+		output, truncated, err := readConsoleOutput(context.Background(), conn, 12)
+		assert.NoError(t, err)
+		assert.True(t, truncated)
+		assert.Equal(t, "0123456789ab", output)
+	})
+
+	t.Run("replaces invalid UTF-8", func(t *testing.T) {
+		t.Parallel()
+		server := consoleServer(t, "boot\xff\r\n")
+		defer server.Close()
+		conn := dialConsole(t, server)
+		defer conn.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		output, _, err := readConsoleOutput(ctx, conn, defaultConsoleMaxBytes)
+		assert.NoError(t, err)
+		assert.Equal(t, "boot�\r\n", output)
+	})
+}
+
+func TestLastLines(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "c\nd", lastLines("a\r\nb\r\nc\r\nd\r\n", 2))
+	assert.Equal(t, "a\nb", lastLines("a\nb", 5))
+}
@@ -7,16 +7,18 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	validate "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	iaas "github.com/thalassa-cloud/client-go/iaas"
 	tcclient "github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/thalassa"
 	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
 	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
 )
 
 func resourceBlockVolume() *schema.Resource {
-	return &schema.Resource{
+	return provider.WithIDIdentity(&schema.Resource{
 		Description: `
 		Provides a Thalassa Cloud Block Volume resource. This can be used to create, manage, and attach a detachable storage device to a virtual machine instance. 
 		`,
@@ -25,6 +27,15 @@ func resourceBlockVolume() *schema.Resource {
 		ReadContext:   resourceBlockVolumeRead,
 		UpdateContext: resourceBlockVolumeUpdate,
 		DeleteContext: resourceBlockVolumeDelete,
+		CustomizeDiff: customdiff.All(
+			rejectVolumeShrink("size_gb"),
+			provider.QuotaPreflight("thalassa_block_volume", blockVolumeQuotaDemand),
+		),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -42,6 +53,12 @@ func resourceBlockVolume() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation of the Block Volume. If not provided, the organisation of the (Terraform) provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"status": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -89,7 +106,17 @@ func resourceBlockVolume() *schema.Resource {
 				Type:         schema.TypeInt,
 				Required:     true,
 				ValidateFunc: validate.IntAtLeast(1),
-				Description:  "Size of the Block Volume in GB",
+				Description:  "Size of the Block Volume in GB. The size can only be increased; volumes attached to a running virtual machine instance are expanded online.",
+			},
+			"attached_machine_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identity of the virtual machine instance the Block Volume is attached to, if any",
+			},
+			"device_serial": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Serial of the Block Volume as seen by the virtual machine instance it is attached to, e.g. to find the device under /dev/disk/by-id",
 			},
 			"wait_until_ready": {
 				Type:        schema.TypeBool,
@@ -100,7 +127,7 @@ func resourceBlockVolume() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-	}
+	})
 }
 
 func resourceBlockVolumeCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -184,15 +211,10 @@ func resourceBlockVolumeCreate(ctx context.Context, d *schema.ResourceData, m an
 
 	if d.Get("wait_until_ready").(bool) {
 		// wait until the volume is ready
-		for {
-			blockVolume, err := client.IaaS().GetVolume(ctx, blockVolume.Identity)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("failed to check block volume status: %w", err))
-			}
-			if strings.EqualFold(blockVolume.Status, "available") || strings.EqualFold(blockVolume.Status, "ready") {
-				break
-			}
-			time.Sleep(1 * time.Second)
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+		defer cancel()
+		if _, err := waitForReadyBlockVolume(ctxWithTimeout, client, blockVolume.Identity); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -227,6 +249,7 @@ func resourceBlockVolumeRead(ctx context.Context, d *schema.ResourceData, m any)
 	_ = d.Set("annotations", blockVolume.Annotations)
 	_ = d.Set("status", blockVolume.Status)
 	_ = d.Set("size_gb", blockVolume.Size)
+	setBlockVolumeAttachmentFields(d, blockVolume)
 
 	if blockVolume.VolumeType != nil {
 		convert.SetReferenceField(d, "volume_type", blockVolume.VolumeType.Identity, "", blockVolume.VolumeType.Name)
@@ -268,6 +291,20 @@ func resourceBlockVolumeUpdate(ctx context.Context, d *schema.ResourceData, m an
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to update block volume: %w", err))
 	}
+
+	var diags diag.Diagnostics
+	if d.HasChange("size_gb") {
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+		defer cancel()
+		blockVolume, err = waitForBlockVolumeSize(ctxWithTimeout, client, identity, updateBlockVolume.Size)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if attachment := machineAttachment(blockVolume); attachment != nil {
+			diags = append(diags, filesystemResizeWarning(identity, attachment.AttachedToIdentity, updateBlockVolume.Size))
+		}
+	}
+
 	if blockVolume != nil {
 		_ = d.Set("name", blockVolume.Name)
 		_ = d.Set("description", blockVolume.Description)
@@ -279,10 +316,23 @@ func resourceBlockVolumeUpdate(ctx context.Context, d *schema.ResourceData, m an
 			convert.SetReferenceField(d, "volume_type", blockVolume.VolumeType.Identity, "", blockVolume.VolumeType.Name)
 		}
 		_ = d.Set("size_gb", blockVolume.Size)
-		return nil
+		setBlockVolumeAttachmentFields(d, blockVolume)
+		return diags
 	}
 
-	return resourceBlockVolumeRead(ctx, d, m)
+	return append(diags, resourceBlockVolumeRead(ctx, d, m)...)
+}
+
+// setBlockVolumeAttachmentFields sets the virtual machine instance the volume is attached to and its device serial.
+func setBlockVolumeAttachmentFields(d *schema.ResourceData, volume *iaas.Volume) {
+	attachment := machineAttachment(volume)
+	if attachment == nil {
+		_ = d.Set("attached_machine_id", "")
+		_ = d.Set("device_serial", "")
+		return
+	}
+	_ = d.Set("attached_machine_id", attachment.AttachedToIdentity)
+	_ = d.Set("device_serial", attachment.Serial)
 }
 
 func resourceBlockVolumeDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -300,31 +350,19 @@ func resourceBlockVolumeDelete(ctx context.Context, d *schema.ResourceData, m an
 		}
 	}
 
-	ctxWithTimeout, cancel := context.WithTimeout(ctx, 10*time.Minute)
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
 	defer cancel()
-	for {
-		select {
-		case <-ctxWithTimeout.Done():
-			return diag.FromErr(fmt.Errorf("timeout waiting for block volume to be deleted"))
-		case <-time.After(1 * time.Second):
-		default:
-		}
-
-		blockVolume, err := client.IaaS().GetVolume(ctxWithTimeout, identity)
-		if err != nil {
-			if tcclient.IsNotFound(err) {
-				break
-			}
-			return diag.FromErr(fmt.Errorf("failed to retrieve block volume: %w", err))
-		}
-		if blockVolume == nil {
-			break
-		}
-		if strings.EqualFold(blockVolume.Status, "deleted") {
-			break
-		}
+	if err := waitForDeletedBlockVolume(ctxWithTimeout, client, identity); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
 	return nil
 }
+
+func blockVolumeQuotaDemand(_ context.Context, d *schema.ResourceDiff, _ thalassa.Client) (provider.QuotaDemand, error) {
+	return provider.QuotaDemand{
+		provider.QuotaBlockVolumes:   1,
+		provider.QuotaBlockStorageGB: int64(d.Get("size_gb").(int)),
+	}, nil
+}
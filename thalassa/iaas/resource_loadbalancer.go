@@ -16,12 +16,17 @@ import (
 )
 
 func resourceLoadBalancer() *schema.Resource {
-	return &schema.Resource{
+	return provider.WithIDIdentity(&schema.Resource{
 		Description:   "Create an loadbalancer within a VPC",
 		CreateContext: resourceLoadBalancerCreate,
 		ReadContext:   resourceLoadBalancerRead,
 		UpdateContext: resourceLoadBalancerUpdate,
 		DeleteContext: resourceLoadBalancerDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -33,6 +38,12 @@ func resourceLoadBalancer() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation of the Loadbalancer. If not provided, the organisation of the (Terraform) provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"region": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -120,7 +131,7 @@ func resourceLoadBalancer() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-	}
+	})
 }
 
 func resourceLoadBalancerCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -176,26 +187,16 @@ func resourceLoadBalancerCreate(ctx context.Context, d *schema.ResourceData, m a
 	}
 
 	// wait until the loadbalancer is ready
-	ctxWithTimeout, cancel := context.WithTimeout(ctx, 20*time.Minute)
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
 	defer cancel()
-	for {
-		select {
-		case <-ctxWithTimeout.Done():
-			return diag.FromErr(fmt.Errorf("timeout while waiting for loadbalancer to be ready"))
-		case <-time.After(1 * time.Second):
-		}
-		loadbalancer, err = client.IaaS().GetLoadbalancer(ctxWithTimeout, loadbalancer.Identity)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		if loadbalancer.Status == "ready" {
-			if len(loadbalancer.ExternalIpAddresses) > 0 {
-				_ = d.Set("ip_address", loadbalancer.ExternalIpAddresses[0])
-			}
-			_ = d.Set("external_ip_addresses", loadbalancer.ExternalIpAddresses)
-			break
-		}
+	loadbalancer, err = waitForReadyLoadbalancer(ctxWithTimeout, client, loadbalancer.Identity)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(loadbalancer.ExternalIpAddresses) > 0 {
+		_ = d.Set("ip_address", loadbalancer.ExternalIpAddresses[0])
 	}
+	_ = d.Set("external_ip_addresses", loadbalancer.ExternalIpAddresses)
 
 	return resourceLoadBalancerRead(ctx, d, m)
 }
@@ -285,25 +286,10 @@ func resourceLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData, m a
 		return nil
 	}
 
-	ctxWithTimeout, cancel := context.WithTimeout(ctx, 20*time.Minute)
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
 	defer cancel()
-	for {
-		select {
-		case <-ctxWithTimeout.Done():
-			return diag.FromErr(fmt.Errorf("timeout while waiting for loadbalancer to be ready"))
-		case <-time.After(1 * time.Second):
-		}
-		// continue
-		loadbalancer, err = client.IaaS().GetLoadbalancer(ctxWithTimeout, slug)
-		if err != nil {
-			if tcclient.IsNotFound(err) {
-				return diag.FromErr(fmt.Errorf("loadbalancer %s was not found after update", slug))
-			}
-			return diag.FromErr(err)
-		}
-		if loadbalancer.Status == "ready" {
-			break
-		}
+	if _, err := waitForReadyLoadbalancer(ctxWithTimeout, client, slug); err != nil {
+		return diag.FromErr(err)
 	}
 
 	return resourceLoadBalancerRead(ctx, d, m)
@@ -325,26 +311,10 @@ func resourceLoadBalancerDelete(ctx context.Context, d *schema.ResourceData, m a
 	}
 
 	// wait until the loadbalancer is deleted
-	ctxWithTimeout, cancel := context.WithTimeout(ctx, 20*time.Minute)
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
 	defer cancel()
-
-	for {
-		select {
-		case <-ctxWithTimeout.Done():
-			return diag.FromErr(fmt.Errorf("timeout while waiting for loadbalancer to be deleted"))
-		case <-time.After(1 * time.Second):
-		}
-		loadbalancer, err := client.IaaS().GetLoadbalancer(ctxWithTimeout, id)
-		if err != nil {
-			if tcclient.IsNotFound(err) {
-				d.SetId("")
-				return nil
-			}
-			return diag.FromErr(err)
-		}
-		if loadbalancer.Status == "deleted" {
-			break
-		}
+	if err := waitForDeletedLoadbalancer(ctxWithTimeout, client, id); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
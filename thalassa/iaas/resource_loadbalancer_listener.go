@@ -59,6 +59,12 @@ func resourceLoadBalancerListener() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation of the Loadbalancer Listener. If not provided, the organisation of the (Terraform) provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"loadbalancer_id": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -3,7 +3,6 @@ package iaas
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -17,12 +16,16 @@ import (
 )
 
 func resourceNatGateway() *schema.Resource {
-	return &schema.Resource{
+	return provider.WithIDIdentity(&schema.Resource{
 		Description:   "Create an NAT Gateway within a VPC",
 		CreateContext: resourceNatGatewayCreate,
 		ReadContext:   resourceNatGatewayRead,
 		UpdateContext: resourceNatGatewayUpdate,
 		DeleteContext: resourceNatGatewayDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -34,6 +37,12 @@ func resourceNatGateway() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation of the NatGateway. If not provided, the organisation of the (Terraform) provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"vpc_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -121,7 +130,7 @@ func resourceNatGateway() *schema.Resource {
 			}
 			return nil
 		},
-	}
+	})
 }
 
 func resourceNatGatewayCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -156,33 +165,16 @@ func resourceNatGatewayCreate(ctx context.Context, d *schema.ResourceData, m any
 		createdIdentity := natGateway.Identity
 
 		// wait until the natGateway is ready and has an endpoint IP
-		ctxWithTimeout, cancel := context.WithTimeout(ctx, 20*time.Minute)
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
 		defer cancel()
-		for {
-			select {
-			case <-ctxWithTimeout.Done():
-				return diag.FromErr(fmt.Errorf("timeout while waiting for natGateway to be ready"))
-			case <-time.After(1 * time.Second):
-				// continue
-				natGateway, err = client.IaaS().GetNatGateway(ctxWithTimeout, createdIdentity)
-				if err != nil {
-					if tcclient.IsNotFound(err) {
-						return diag.FromErr(fmt.Errorf("natGateway %s was not found after creation", createdIdentity))
-					}
-					return diag.FromErr(err)
-				}
-				if natGateway == nil {
-					return diag.FromErr(fmt.Errorf("natGateway %s was not found after creation", createdIdentity))
-				}
-				if strings.TrimSpace(natGateway.EndpointIP) != "" {
-					_ = d.Set("status", natGateway.Status)
-					_ = d.Set("endpoint_ip", natGateway.EndpointIP)
-					_ = d.Set("v4_ip", natGateway.V4IP)
-					_ = d.Set("v6_ip", natGateway.V6IP)
-					return resourceNatGatewayRead(ctx, d, m)
-				}
-			}
+		natGateway, err = waitForAvailableNatGateway(ctxWithTimeout, client, createdIdentity)
+		if err != nil {
+			return diag.FromErr(err)
 		}
+		_ = d.Set("status", natGateway.Status)
+		_ = d.Set("endpoint_ip", natGateway.EndpointIP)
+		_ = d.Set("v4_ip", natGateway.V4IP)
+		_ = d.Set("v6_ip", natGateway.V6IP)
 	}
 	return resourceNatGatewayRead(ctx, d, m)
 }
@@ -282,9 +274,9 @@ func resourceNatGatewayDelete(ctx context.Context, d *schema.ResourceData, m any
 	}
 
 	// wait until the natGateway is deleted
-	ctxWithTimeout, cancel := context.WithTimeout(ctx, 20*time.Minute)
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
 	defer cancel()
-	if err := client.IaaS().WaitUntilNatGatewayDeleted(ctxWithTimeout, id); err != nil {
+	if err := waitForDeletedNatGateway(ctxWithTimeout, client, id); err != nil {
 		return diag.FromErr(fmt.Errorf("error waiting for natGateway to be deleted: %w", err))
 	}
 	d.SetId("")
@@ -6,11 +6,14 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	validate "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	tcclient "github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/thalassa"
 	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
 	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/waiter"
 
 	iaas "github.com/thalassa-cloud/client-go/iaas"
 )
@@ -22,6 +25,9 @@ func resourceReservedIP() *schema.Resource {
 		ReadContext:   resourceReservedIPRead,
 		UpdateContext: resourceReservedIPUpdate,
 		DeleteContext: resourceReservedIPDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -33,6 +39,12 @@ func resourceReservedIP() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation of the reserved IP. If not provided, the organisation configured in the Terraform provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"region": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -98,16 +110,20 @@ func resourceReservedIP() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+		CustomizeDiff: customdiff.All(func(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
 			_, new := diff.GetChange("description")
 			if new == nil {
 				return diff.SetNew("description", "")
 			}
 			return nil
-		},
+		}, provider.QuotaPreflight("thalassa_reserved_ip", reservedIPQuotaDemand)),
 	}
 }
 
+func reservedIPQuotaDemand(_ context.Context, _ *schema.ResourceDiff, _ thalassa.Client) (provider.QuotaDemand, error) {
+	return provider.QuotaDemand{provider.QuotaReservedIPs: 1}, nil
+}
+
 func resourceReservedIPCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	client, err := provider.GetClient(provider.GetProvider(m), d)
 	if err != nil {
@@ -154,33 +170,21 @@ func resourceReservedIPCreate(ctx context.Context, d *schema.ResourceData, m any
 
 	d.SetId(fip.Identity)
 
-	ctxWithTimeout, cancel := context.WithTimeout(ctx, 20*time.Minute)
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
 	defer cancel()
-	for {
-		select {
-		case <-ctxWithTimeout.Done():
-			return diag.FromErr(fmt.Errorf("timeout while waiting for reserved IP to become available"))
-		case <-time.After(1 * time.Second):
-		}
-		fip, err = client.IaaS().GetReservedIP(ctxWithTimeout, fip.Identity)
-		if err != nil {
-			if tcclient.IsNotFound(err) {
-				return diag.FromErr(fmt.Errorf("reserved IP %s was not found after creation", d.Id()))
-			}
-			return diag.FromErr(err)
-		}
-		if fip == nil {
-			return diag.FromErr(fmt.Errorf("reserved IP %s was not found after creation", d.Id()))
-		}
-		switch fip.Status {
-		case iaas.ReservedIpStatusAvailable, iaas.ReservedIpStatusAttached:
-			return resourceReservedIPRead(ctx, d, m)
-		case iaas.ReservedIpStatusFailed:
-			return diag.FromErr(fmt.Errorf("reserved IP provisioning failed (status: %s)", fip.Status))
-		case iaas.ReservedIpStatusDeleted, iaas.ReservedIpStatusDeleting:
-			return diag.FromErr(fmt.Errorf("reserved IP entered unexpected status %s", fip.Status))
-		}
+	_, err = waiter.Waiter[*iaas.ReservedIP]{
+		Description: fmt.Sprintf("reserved IP %q", fip.Identity),
+		Refresh: func(ctx context.Context) (*iaas.ReservedIP, error) {
+			return client.IaaS().GetReservedIP(ctx, fip.Identity)
+		},
+		State:  func(fip *iaas.ReservedIP) string { return string(fip.Status) },
+		Target: []string{string(iaas.ReservedIpStatusAvailable), string(iaas.ReservedIpStatusAttached)},
+		Failed: []string{string(iaas.ReservedIpStatusFailed), string(iaas.ReservedIpStatusDeleting), string(iaas.ReservedIpStatusDeleted)},
+	}.Wait(ctxWithTimeout)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	return resourceReservedIPRead(ctx, d, m)
 }
 
 func resourceReservedIPRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
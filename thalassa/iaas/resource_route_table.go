@@ -11,17 +11,21 @@ import (
 	tcclient "github.com/thalassa-cloud/client-go/pkg/client"
 	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
 	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/waiter"
 
 	iaas "github.com/thalassa-cloud/client-go/iaas"
 )
 
 func resourceRouteTable() *schema.Resource {
-	return &schema.Resource{
+	return provider.WithIDIdentity(&schema.Resource{
 		Description:   "Create an routeTable",
 		CreateContext: resourceRouteTableCreate,
 		ReadContext:   resourceRouteTableRead,
 		UpdateContext: resourceRouteTableUpdate,
 		DeleteContext: resourceRouteTableDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -33,6 +37,12 @@ func resourceRouteTable() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation of the RouteTable. If not provided, the organisation of the (Terraform) provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"vpc_id": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -73,7 +83,7 @@ func resourceRouteTable() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-	}
+	})
 }
 
 func resourceRouteTableCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -201,16 +211,21 @@ func resourceRouteTableDelete(ctx context.Context, d *schema.ResourceData, m any
 	}
 
 	// wait until the route table is deleted
-	for {
-		select {
-		case <-ctx.Done():
-			return diag.FromErr(fmt.Errorf("timeout while waiting for route table to be deleted"))
-		case <-time.After(1 * time.Second):
-			_, err := client.IaaS().GetRouteTable(ctx, id)
-			if err != nil && tcclient.IsNotFound(err) {
-				d.SetId("")
-				return nil
-			}
-		}
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+	_, err = waiter.Waiter[*iaas.RouteTable]{
+		Description: fmt.Sprintf("route table %q", id),
+		Refresh: func(ctx context.Context) (*iaas.RouteTable, error) {
+			return client.IaaS().GetRouteTable(ctx, id)
+		},
+		// Route tables have no status, they exist until they have been deleted.
+		State:          func(*iaas.RouteTable) string { return "deleting" },
+		TargetNotFound: true,
+	}.Wait(ctxWithTimeout)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+
+	d.SetId("")
+	return nil
 }
@@ -31,6 +31,12 @@ func resourceRouteTableRoute() *schema.Resource {
 				ForceNew:    true,
 				Description: "Organisation of the RouteTable",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"route_table_id": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -15,7 +15,7 @@ import (
 )
 
 func ResourceSecurityGroup() *schema.Resource {
-	return &schema.Resource{
+	return provider.WithIDIdentity(&schema.Resource{
 		Description:   "A security group is a collection of rules that control the traffic to and from a virtual machine instance or other cloud resource within a VPC.",
 		CreateContext: resourceSecurityGroupCreate,
 		ReadContext:   resourceSecurityGroupRead,
@@ -54,6 +54,12 @@ func ResourceSecurityGroup() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation of the Security Group. If not provided, the organisation of the (Terraform) provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"vpc_id": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -223,7 +229,7 @@ func ResourceSecurityGroup() *schema.Resource {
 				Description: "Status of the security group",
 			},
 		},
-	}
+	})
 }
 
 func resourceSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -3,7 +3,6 @@ package iaas
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -24,6 +23,10 @@ func resourceSnapshot() *schema.Resource {
 		ReadContext:   resourceSnapshotRead,
 		UpdateContext: resourceSnapshotUpdate,
 		DeleteContext: resourceSnapshotDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -35,6 +38,12 @@ func resourceSnapshot() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation of the Snapshot. If not provided, the organisation of the (Terraform) provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -146,10 +155,10 @@ func resourceSnapshotCreate(ctx context.Context, d *schema.ResourceData, m any)
 	}
 
 	if d.Get("wait_until_available").(bool) {
-		ctxWithTimeout, cancel := context.WithTimeout(ctx, 30*time.Minute)
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
 		defer cancel()
 
-		err = client.IaaS().WaitUntilSnapshotIsAvailable(ctxWithTimeout, snapshot.Identity)
+		err = waitForAvailableSnapshot(ctxWithTimeout, client, snapshot.Identity)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("failed to wait for snapshot to be available: %w", err))
 		}
@@ -255,14 +264,12 @@ func resourceSnapshotDelete(ctx context.Context, d *schema.ResourceData, m any)
 		}
 	}
 
-	ctxWithTimeout, cancel := context.WithTimeout(ctx, 10*time.Minute)
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
 	defer cancel()
 
-	err = client.IaaS().WaitUntilSnapshotIsDeleted(ctxWithTimeout, identity)
+	err = waitForDeletedSnapshot(ctxWithTimeout, client, identity)
 	if err != nil {
-		if !strings.Contains(err.Error(), "timeout") {
-			return diag.FromErr(fmt.Errorf("failed to wait for snapshot deletion: %w", err))
-		}
+		return diag.FromErr(fmt.Errorf("failed to wait for snapshot deletion: %w", err))
 	}
 
 	d.SetId("")
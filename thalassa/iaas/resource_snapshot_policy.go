@@ -37,6 +37,12 @@ func resourceSnapshotPolicy() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation of the Snapshot Policy. If not provided, the organisation of the (Terraform) provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
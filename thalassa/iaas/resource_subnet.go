@@ -16,7 +16,7 @@ import (
 )
 
 func resourceSubnet() *schema.Resource {
-	return &schema.Resource{
+	return provider.WithIDIdentity(&schema.Resource{
 		Description: "Create a subnet in a VPC. Subnets provide network segments for resources. " +
 			"A VPC can have multiple subnets with unique CIDR blocks. IPv4, IPv6, and dual-stack are supported. " +
 			"The CIDR cannot be changed after creation.",
@@ -24,6 +24,11 @@ func resourceSubnet() *schema.Resource {
 		ReadContext:   resourceSubnetRead,
 		UpdateContext: resourceSubnetUpdate,
 		DeleteContext: resourceSubnetDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -35,6 +40,12 @@ func resourceSubnet() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation of the Subnet. If not provided, the organisation of the (Terraform) provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"vpc_id": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -118,7 +129,7 @@ func resourceSubnet() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-	}
+	})
 }
 
 func resourceSubnetCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -152,10 +163,10 @@ func resourceSubnetCreate(ctx context.Context, d *schema.ResourceData, m any) di
 		_ = d.Set("status", subnet.Status)
 
 		// wait until the subnet is ready
-		ctxWithTimeout, cancel := context.WithTimeout(ctx, 20*time.Minute)
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
 		defer cancel()
 
-		if subnet, err = client.IaaS().WaitUntilSubnetReady(ctxWithTimeout, subnet.Identity); err != nil {
+		if subnet, err = waitForReadySubnet(ctxWithTimeout, client, subnet.Identity); err != nil {
 			return diag.FromErr(fmt.Errorf("error waiting for subnet to be ready: %w", err))
 		}
 
@@ -252,10 +263,10 @@ func resourceSubnetUpdate(ctx context.Context, d *schema.ResourceData, m any) di
 			_ = d.Set("route_table_id", subnet.RouteTable.Identity)
 		}
 
-		ctxWithTimeout, cancel := context.WithTimeout(ctx, 20*time.Minute)
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
 		defer cancel()
 
-		if subnet, err = client.IaaS().WaitUntilSubnetReady(ctxWithTimeout, subnet.Identity); err != nil {
+		if subnet, err = waitForReadySubnet(ctxWithTimeout, client, subnet.Identity); err != nil {
 			return diag.FromErr(fmt.Errorf("error waiting for subnet to be ready: %w", err))
 		}
 
@@ -285,42 +296,13 @@ func resourceSubnetDelete(ctx context.Context, d *schema.ResourceData, m any) di
 		return diag.FromErr(err)
 	}
 
-	ctxWithTimeout, cancel := context.WithTimeout(ctx, 20*time.Minute)
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
 	defer cancel()
 
-	for {
-		select {
-		case <-ctxWithTimeout.Done():
-			return diag.FromErr(fmt.Errorf("timeout waiting for subnet %s to be deleted: %w", id, ctxWithTimeout.Err()))
-		default:
-		}
-
-		subnet, err := client.IaaS().GetSubnet(ctx, id)
-		if err != nil {
-			if tcclient.IsNotFound(err) {
-				d.SetId("")
-				return nil
-			}
-			return diag.FromErr(err)
-		}
-
-		switch subnet.Status {
-		case iaas.SubnetStatusDeleted:
-			d.SetId("")
-			return nil
-		case iaas.SubnetStatusDeleting:
-			// Deletion in progress; keep polling.
-		case iaas.SubnetStatusReady, iaas.SubnetStatusActive:
-			// The delete API can return before the subnet transitions to deleting.
-			if err := client.IaaS().DeleteSubnet(ctx, id); err != nil && !tcclient.IsNotFound(err) {
-				return diag.FromErr(err)
-			}
-		case iaas.SubnetStatusFailed:
-			return diag.FromErr(fmt.Errorf("subnet %s failed to delete (status: %s)", id, subnet.Status))
-		default:
-			return diag.FromErr(fmt.Errorf("subnet %s unexpected status during delete: %s", id, subnet.Status))
-		}
-
-		time.Sleep(iaas.DefaultPollIntervalForWaiting)
+	if err := waitForDeletedSubnet(ctxWithTimeout, client, id); err != nil {
+		return diag.FromErr(err)
 	}
+
+	d.SetId("")
+	return nil
 }
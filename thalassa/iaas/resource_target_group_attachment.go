@@ -29,6 +29,12 @@ func resourceTargetGroupAttachment() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation of the Target Group Attachment. If not provided, the organisation of the (Terraform) provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"target_group_id": {
 				Type:        schema.TypeString,
 				Required:    true,
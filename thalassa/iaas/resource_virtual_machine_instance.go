@@ -2,28 +2,46 @@ package iaas
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	validate "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	tcclient "github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/thalassa"
 	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
 	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
 
 	iaas "github.com/thalassa-cloud/client-go/iaas"
 )
 
+const (
+	resizeStrategyStopStart             = "stop_start"
+	resizeStrategyFailIfRestartRequired = "fail_if_restart_required"
+)
+
+// machineMustBeStoppedCode is the code of the error the IaaS API returns when a region can only apply an update to a
+// stopped virtual machine instance.
+const machineMustBeStoppedCode = "machine_must_be_stopped"
+
 func resourceVirtualMachineInstance() *schema.Resource {
-	return &schema.Resource{
+	return provider.WithIDIdentity(&schema.Resource{
 		Description:   "Create an virtual machine instance within a subnet on the Thalassa Cloud platform",
 		CreateContext: resourceVirtualMachineInstanceCreate,
 		ReadContext:   resourceVirtualMachineInstanceRead,
 		UpdateContext: resourceVirtualMachineInstanceUpdate,
 		DeleteContext: resourceVirtualMachineInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -32,8 +50,7 @@ func resourceVirtualMachineInstance() *schema.Resource {
 			"subnet_id": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
-				Description: "Subnet of the Virtual Machine Instance",
+				Description: "Subnet of the Virtual Machine Instance. Changing the subnet migrates the virtual machine instance, see resize_strategy.",
 			},
 			"organisation_id": {
 				Type:        schema.TypeString,
@@ -41,11 +58,16 @@ func resourceVirtualMachineInstance() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation of the Machine Type. If not provided, the organisation of the (Terraform) provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ValidateFunc: validate.StringLenBetween(1, 62),
-				ForceNew:     true,
 				Description:  "Name of the Virtual Machine Instance",
 			},
 			"slug": {
@@ -76,13 +98,19 @@ func resourceVirtualMachineInstance() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
-				Description: "Availability zone of the virtual machine instance",
+				Description: "Availability zone of the virtual machine instance. Changing the availability zone migrates the virtual machine instance, see resize_strategy.",
 			},
 			"machine_type": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
-				Description: "Machine type of the virtual machine instance",
+				Description: "Machine type of the virtual machine instance. Changing the machine type resizes the virtual machine instance, see resize_strategy.",
+			},
+			"resize_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      resizeStrategyStopStart,
+				ValidateFunc: validate.StringInSlice([]string{resizeStrategyStopStart, resizeStrategyFailIfRestartRequired}, false),
+				Description:  "How to change the machine type, subnet or availability zone of a running virtual machine instance when the region cannot do so without a restart. `stop_start` stops the virtual machine instance, applies the change and starts it again. `fail_if_restart_required` fails the update instead, and fails the plan when the availability zone of a running virtual machine instance changes, as regions that cannot live migrate restart the virtual machine instance to move it. Defaults to `stop_start`.",
 			},
 			"machine_image": {
 				Type:        schema.TypeString,
@@ -106,6 +134,14 @@ func resourceVirtualMachineInstance() *schema.Resource {
 				ForceNew:    true,
 				Description: "Cloud init template id of the virtual machine instance. If provided, the cloud init will be set to the content of the template.",
 			},
+			"template_variables": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"cloud_init_template_id"},
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Description:  "Variables rendered into the content of the cloud init template referenced by cloud_init_template_id. The template references them as `{{ .name }}`; referencing a variable that is not set fails the create. If not set, the template content is used as is.",
+			},
 			"root_volume_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -115,7 +151,8 @@ func resourceVirtualMachineInstance() *schema.Resource {
 			"root_volume_size_gb": {
 				Type:        schema.TypeInt,
 				Optional:    true,
-				Description: "Root volume size of the virtual machine instance. Must be provided if root_volume_id is not set.",
+				Computed:    true,
+				Description: "Root volume size of the virtual machine instance. Must be provided if root_volume_id is not set. Increasing it expands the root volume in place; it cannot be decreased.",
 			},
 			"root_volume_type": {
 				Type:        schema.TypeString,
@@ -132,6 +169,19 @@ func resourceVirtualMachineInstance() *schema.Resource {
 				Computed:    true,
 				Description: "Desired state of the virtual machine instance. Can be 'running', 'stopped', 'deleted'",
 			},
+			"desired_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.StringInSlice([]string{string(iaas.MachineStateRunning), string(iaas.MachineStateStopped)}, false),
+				Description:  "Power state of the virtual machine instance, `running` or `stopped`. Changing it starts or stops the virtual machine instance. If not set, the virtual machine instance is created running and its power state is not managed.",
+			},
+			"restart_triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, restart the running virtual machine instance, for example a hash of the cloud-init configuration.",
+			},
 			"ip_addresses": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -148,6 +198,12 @@ func resourceVirtualMachineInstance() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"capture_console_on_failure": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Capture the console output of the virtual machine instance when it enters a failed state while it is created, and include its last lines in the error. Useful to debug cloud-init failures.",
+			},
 			"security_group_attachments": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -161,7 +217,7 @@ func resourceVirtualMachineInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+		CustomizeDiff: customdiff.All(func(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
 			// Get all values from the diff
 			rootVolumeID := diff.Get("root_volume_id")
 			rootVolumeSize := diff.Get("root_volume_size_gb")
@@ -190,8 +246,20 @@ func resourceVirtualMachineInstance() *schema.Resource {
 			}
 
 			return nil
-		},
+		}, rejectVolumeShrink("root_volume_size_gb"), rejectAvailabilityZoneMoveWithoutRestart, provider.QuotaPreflight("thalassa_virtual_machine_instance", virtualMachineInstanceQuotaDemand)),
+	})
+}
+
+func virtualMachineInstanceQuotaDemand(ctx context.Context, d *schema.ResourceDiff, client thalassa.Client) (provider.QuotaDemand, error) {
+	demand, err := provider.MachineQuotaDemand(ctx, client, d.Get("machine_type").(string), 1)
+	if err != nil {
+		return nil, err
+	}
+	if d.Get("root_volume_id").(string) == "" {
+		demand[provider.QuotaBlockVolumes] = 1
+		demand[provider.QuotaBlockStorageGB] = int64(d.Get("root_volume_size_gb").(int))
 	}
+	return demand, nil
 }
 
 func resourceVirtualMachineInstanceCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -250,12 +318,27 @@ func resourceVirtualMachineInstanceCreate(ctx context.Context, d *schema.Resourc
 		}
 		_ = d.Set("cloud_init_template_id", cloudInitTemplate.Identity)
 		createVirtualMachineInstance.CloudInit = cloudInitTemplate.Content
+		if variables := convert.ConvertToMap(d.Get("template_variables")); len(variables) > 0 {
+			createVirtualMachineInstance.CloudInit, err = renderCloudInitTemplate(cloudInitTemplate.Content, variables)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 
 	if availabilityZone, ok := d.GetOk("availability_zone"); ok {
 		createVirtualMachineInstance.AvailabilityZone = convert.Ptr(availabilityZone.(string))
 	}
 
+	targetStatuses := machineStartOperation.targetStatuses
+	if desiredState, ok := d.GetOk("desired_state"); ok {
+		state := iaas.MachineState(desiredState.(string))
+		createVirtualMachineInstance.State = &state
+		if state == iaas.MachineStateStopped {
+			targetStatuses = machineStopOperation.targetStatuses
+		}
+	}
+
 	virtualMachineInstance, err := client.IaaS().CreateMachine(ctx, createVirtualMachineInstance)
 
 	if err != nil {
@@ -270,48 +353,30 @@ func resourceVirtualMachineInstanceCreate(ctx context.Context, d *schema.Resourc
 		_ = d.Set("slug", virtualMachineInstance.Slug)
 
 		// wait until the virtual machine instance is ready
-		ctxWithTimeout, cancel := context.WithTimeout(ctx, 20*time.Minute)
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
 		defer cancel()
-		for {
-			select {
-			case <-ctxWithTimeout.Done():
-				return diag.FromErr(fmt.Errorf("timeout while waiting for virtual machine instance to be ready"))
-			case <-time.After(1 * time.Second):
-				// continue
-				virtualMachineInstance, err = client.IaaS().GetMachine(ctxWithTimeout, identity)
-				if err != nil {
-					if tcclient.IsNotFound(err) {
-						return diag.FromErr(fmt.Errorf("virtual machine instance %s was not found after creation", identity))
-					}
-					return diag.FromErr(err)
-				}
-				if virtualMachineInstance == nil {
-					return diag.FromErr(fmt.Errorf("virtual machine instance %s was not found after creation", identity))
-				}
-
-				if strings.EqualFold(virtualMachineInstance.Status.Status, "ready") || strings.EqualFold(virtualMachineInstance.Status.Status, "running") {
-					_ = d.Set("ip_addresses", getIPAddresses(virtualMachineInstance))
-					_ = d.Set("attached_volume_ids", getAttachedVolumeIds(virtualMachineInstance))
-					_ = d.Set("status", virtualMachineInstance.Status.Status)
-					_ = d.Set("state", virtualMachineInstance.State)
-
-					securityGroupAttachments := make([]string, len(virtualMachineInstance.SecurityGroups))
-					for i, securityGroup := range virtualMachineInstance.SecurityGroups {
-						securityGroupAttachments[i] = securityGroup.Identity
-					}
-					_ = d.Set("security_group_attachments", securityGroupAttachments)
-
-					if virtualMachineInstance.AvailabilityZone != nil {
-						_ = d.Set("availability_zone", *virtualMachineInstance.AvailabilityZone)
-					} else {
-						_ = d.Set("availability_zone", "")
-					}
-					return nil
-				} else if strings.EqualFold(virtualMachineInstance.Status.Status, "error") || strings.EqualFold(virtualMachineInstance.Status.Status, "failed") {
-					return diag.FromErr(fmt.Errorf("virtual machine instance is in error state: %s", virtualMachineInstance.Status.StatusMessage))
-				}
-			}
+		virtualMachineInstance, err = waitForMachineStatus(ctxWithTimeout, client, identity, targetStatuses)
+		if err != nil {
+			return machineWaitDiagnostics(ctx, m, d, identity, err)
 		}
+		_ = d.Set("ip_addresses", getIPAddresses(virtualMachineInstance))
+		_ = d.Set("attached_volume_ids", getAttachedVolumeIds(virtualMachineInstance))
+		_ = d.Set("status", virtualMachineInstance.Status.Status)
+		_ = d.Set("state", virtualMachineInstance.State)
+		setDesiredStateField(d, virtualMachineInstance.State)
+
+		securityGroupAttachments := make([]string, len(virtualMachineInstance.SecurityGroups))
+		for i, securityGroup := range virtualMachineInstance.SecurityGroups {
+			securityGroupAttachments[i] = securityGroup.Identity
+		}
+		_ = d.Set("security_group_attachments", securityGroupAttachments)
+
+		if virtualMachineInstance.AvailabilityZone != nil {
+			_ = d.Set("availability_zone", *virtualMachineInstance.AvailabilityZone)
+		} else {
+			_ = d.Set("availability_zone", "")
+		}
+		return nil
 	}
 	return resourceVirtualMachineInstanceRead(ctx, d, m)
 }
@@ -344,6 +409,7 @@ func resourceVirtualMachineInstanceRead(ctx context.Context, d *schema.ResourceD
 	_ = d.Set("annotations", virtualMachineInstance.Annotations)
 	_ = d.Set("status", virtualMachineInstance.Status.Status)
 	_ = d.Set("state", virtualMachineInstance.State)
+	setDesiredStateField(d, virtualMachineInstance.State)
 	_ = d.Set("ip_addresses", getIPAddresses(virtualMachineInstance))
 	_ = d.Set("attached_volume_ids", getAttachedVolumeIds(virtualMachineInstance))
 
@@ -405,7 +471,8 @@ func resourceVirtualMachineInstanceUpdate(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(fmt.Errorf("failed to get virtual machine instance: %w", err))
 	}
 
-	state := iaas.MachineState(d.Get("state").(string))
+	// the power state is changed through start and stop below, the update keeps the current one
+	state := currentMachine.State
 	var availabilityZone *string
 	if d.Get("availability_zone").(string) != "" {
 		availabilityZone = convert.Ptr(d.Get("availability_zone").(string))
@@ -431,13 +498,48 @@ func resourceVirtualMachineInstanceUpdate(ctx context.Context, d *schema.Resourc
 
 	identity := d.Get("id").(string)
 
-	virtualMachineInstance, err := client.IaaS().UpdateMachine(ctx, identity, updateVirtualMachineInstance)
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	var diags diag.Diagnostics
+	if rootVolumeSize := d.Get("root_volume_size_gb").(int); d.HasChange("root_volume_size_gb") && currentMachine.PersistentVolume != nil && rootVolumeSize > currentMachine.PersistentVolume.Size {
+		rootVolume, err := client.IaaS().GetVolume(ctxWithTimeout, currentMachine.PersistentVolume.Identity)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to get root volume: %w", err))
+		}
+		if _, err := expandVolume(ctxWithTimeout, client, rootVolume, rootVolumeSize); err != nil {
+			return diag.FromErr(err)
+		}
+		if state == iaas.MachineStateRunning {
+			diags = append(diags, filesystemResizeWarning(rootVolume.Identity, identity, rootVolumeSize))
+		}
+	}
+
+	virtualMachineInstance, err := updateMachine(ctxWithTimeout, client, identity, updateVirtualMachineInstance)
+	restartFields := virtualMachineInstanceRestartFields(d)
+	if err != nil && stoppedMachineRequired(err) && len(restartFields) > 0 && state == iaas.MachineStateRunning {
+		virtualMachineInstance, err = restartVirtualMachineInstanceForUpdate(ctxWithTimeout, client, identity, updateVirtualMachineInstance, d.Get("resize_strategy").(string), restartFields, err)
+	}
 	if err != nil {
 		if tcclient.IsNotFound(err) {
 			return diag.FromErr(fmt.Errorf("used resource for updating virtual machine instance not found: %w", err))
 		}
 		return diag.FromErr(fmt.Errorf("failed to update virtual machine instance: %w", err))
 	}
+	if len(restartFields) > 0 && state == iaas.MachineStateRunning {
+		// resizes and migrations complete asynchronously, the machine is back once it transitioned and is running again
+		virtualMachineInstance, err = waitForMachineStatusTransition(ctxWithTimeout, client, identity, currentMachine.Status.LastTransitionTime, machineStartOperation.targetStatuses)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if operation, ok := virtualMachineInstancePowerOperation(d, state); ok {
+		virtualMachineInstance, err = applyMachinePowerOperation(ctxWithTimeout, client, identity, operation)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	if virtualMachineInstance != nil {
 		_ = d.Set("name", virtualMachineInstance.Name)
 		_ = d.Set("description", virtualMachineInstance.Description)
@@ -446,6 +548,7 @@ func resourceVirtualMachineInstanceUpdate(ctx context.Context, d *schema.Resourc
 		_ = d.Set("annotations", virtualMachineInstance.Annotations)
 		_ = d.Set("status", virtualMachineInstance.Status.Status)
 		_ = d.Set("state", virtualMachineInstance.State)
+		setDesiredStateField(d, virtualMachineInstance.State)
 
 		_ = d.Set("ip_addresses", getIPAddresses(virtualMachineInstance))
 		_ = d.Set("attached_volume_ids", getAttachedVolumeIds(virtualMachineInstance))
@@ -484,10 +587,10 @@ func resourceVirtualMachineInstanceUpdate(ctx context.Context, d *schema.Resourc
 			}
 		}
 
-		return nil
+		return diags
 	}
 
-	return resourceVirtualMachineInstanceRead(ctx, d, m)
+	return append(diags, resourceVirtualMachineInstanceRead(ctx, d, m)...)
 }
 
 func resourceVirtualMachineInstanceDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -504,28 +607,129 @@ func resourceVirtualMachineInstanceDelete(ctx context.Context, d *schema.Resourc
 	}
 
 	// wait until the virtual machine instance is deleted
-	ctxWithTimeout, cancel := context.WithTimeout(ctx, 20*time.Minute)
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
 	defer cancel()
-	for {
-		select {
-		case <-ctxWithTimeout.Done():
-			return diag.FromErr(fmt.Errorf("timeout while waiting for virtual machine instance to be deleted"))
-		case <-time.After(2 * time.Second):
-			m, err := client.IaaS().GetMachine(ctxWithTimeout, id)
-			if err != nil {
-				if tcclient.IsNotFound(err) {
-					d.SetId("")
-					return nil
-				}
-				return diag.FromErr(fmt.Errorf("error getting virtual machine instance: %s", err))
-			}
+	if err := waitForDeletedMachine(ctxWithTimeout, client, id); err != nil {
+		return diag.FromErr(err)
+	}
 
-			if strings.EqualFold(m.Status.Status, "deleted") {
-				d.SetId("")
-				return nil
-			}
+	d.SetId("")
+	return nil
+}
+
+// virtualMachineInstanceRestartFields returns the changed arguments that may require the virtual machine instance to
+// be restarted, depending on the region.
+func virtualMachineInstanceRestartFields(d *schema.ResourceData) []string {
+	var fields []string
+	for _, field := range []string{"machine_type", "subnet_id", "availability_zone"} {
+		if d.HasChange(field) {
+			fields = append(fields, field)
 		}
 	}
+	return fields
+}
+
+// virtualMachineInstancePowerOperation returns the power operation that brings the machine in the given state to the
+// desired state, or restarts it when one of the restart triggers changed.
+func virtualMachineInstancePowerOperation(d *schema.ResourceData, state iaas.MachineState) (machinePowerOperation, bool) {
+	desiredState := iaas.MachineState(d.Get("desired_state").(string))
+	switch {
+	case d.HasChange("desired_state") && desiredState == iaas.MachineStateRunning && state != iaas.MachineStateRunning:
+		return machineStartOperation, true
+	case d.HasChange("desired_state") && desiredState == iaas.MachineStateStopped && state != iaas.MachineStateStopped:
+		return machineStopOperation, true
+	case d.HasChange("restart_triggers") && state == iaas.MachineStateRunning && desiredState != iaas.MachineStateStopped:
+		return machineRestartOperation, true
+	}
+	return machinePowerOperation{}, false
+}
+
+// restartVirtualMachineInstanceForUpdate applies an update that the region rejected for a running virtual machine
+// instance by stopping it, applying the update and starting it again, unless the resize strategy forbids restarts.
+func restartVirtualMachineInstanceForUpdate(ctx context.Context, client thalassa.Client, identity string, update iaas.UpdateMachine, strategy string, fields []string, cause error) (*iaas.Machine, error) {
+	if strategy == resizeStrategyFailIfRestartRequired {
+		return nil, fmt.Errorf("changing %s of virtual machine instance %q requires a restart, which resize_strategy %q does not allow: %w", strings.Join(fields, ", "), identity, strategy, cause)
+	}
+
+	tflog.Info(ctx, "stopping virtual machine instance to apply update", map[string]any{
+		"identity": identity,
+		"fields":   strings.Join(fields, ","),
+	})
+	if _, err := applyMachinePowerOperation(ctx, client, identity, machineStopOperation); err != nil {
+		return nil, err
+	}
+
+	stopped := iaas.MachineStateStopped
+	update.State = &stopped
+	if _, err := client.IaaS().UpdateMachine(ctx, identity, update); err != nil {
+		// start the machine again, so a rejected update does not leave it stopped
+		if _, startErr := applyMachinePowerOperation(ctx, client, identity, machineStartOperation); startErr != nil {
+			return nil, errors.Join(err, fmt.Errorf("failed to start virtual machine instance %q again: %w", identity, startErr))
+		}
+		return nil, err
+	}
+
+	return applyMachinePowerOperation(ctx, client, identity, machineStartOperation)
+}
+
+// machineUpdateError is a rejected update of a virtual machine instance, with the error code of the API response.
+type machineUpdateError struct {
+	Code string `json:"code"`
+	err  error
+}
+
+func (e *machineUpdateError) Error() string {
+	return e.err.Error()
+}
+
+func (e *machineUpdateError) Unwrap() error {
+	return e.err
+}
+
+// updateMachine updates the virtual machine instance like UpdateMachine of the client, but keeps the error code of a
+// rejected update, which the client drops.
+func updateMachine(ctx context.Context, client thalassa.Client, identity string, update iaas.UpdateMachine) (*iaas.Machine, error) {
+	var machine *iaas.Machine
+	req := client.IaaS().R().SetBody(update).SetResult(&machine)
+	resp, err := client.IaaS().Do(ctx, req, tcclient.PUT, fmt.Sprintf("%s/%s", iaas.MachineEndpoint, identity))
+	if err != nil {
+		return nil, err
+	}
+	if err := client.IaaS().Check(resp); err != nil {
+		updateErr := &machineUpdateError{err: err}
+		_ = json.Unmarshal(resp.Body(), updateErr)
+		return nil, updateErr
+	}
+	return machine, nil
+}
+
+// stoppedMachineRequired reports whether the update of a running virtual machine instance was rejected because the
+// change requires the machine to be stopped. Other rejections, e.g. of an unknown machine type, are not retried with
+// a restart.
+func stoppedMachineRequired(err error) bool {
+	var updateErr *machineUpdateError
+	return errors.As(err, &updateErr) && updateErr.Code == machineMustBeStoppedCode
+}
+
+// rejectAvailabilityZoneMoveWithoutRestart fails the plan when the availability zone of a running virtual machine
+// instance changes while resize_strategy forbids restarts. Regions that cannot live migrate a machine stop and restart
+// it to move it to another zone, without rejecting the update first.
+func rejectAvailabilityZoneMoveWithoutRestart(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	if diff.Id() == "" || !diff.HasChange("availability_zone") || diff.Get("resize_strategy").(string) != resizeStrategyFailIfRestartRequired {
+		return nil
+	}
+	if diff.Get("state").(string) != string(iaas.MachineStateRunning) {
+		return nil
+	}
+	return fmt.Errorf("availability_zone of a running virtual machine instance cannot be changed with resize_strategy %q: the region may restart the virtual machine instance to move it to another zone", resizeStrategyFailIfRestartRequired)
+}
+
+// setDesiredStateField sets desired_state from the state of the machine, so power state changes made outside of
+// Terraform show up as drift. Transitional states, such as deleting, are not reflected.
+func setDesiredStateField(d *schema.ResourceData, state iaas.MachineState) {
+	if state == iaas.MachineStateRunning || state == iaas.MachineStateStopped {
+		_ = d.Set("desired_state", string(state))
+	}
 }
 
 // setMachineTypeField keeps the user's reference (identity, slug, or name) when it still matches the API value.
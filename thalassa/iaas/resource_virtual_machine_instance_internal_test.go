@@ -1,12 +1,22 @@
 package iaas
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 
 	iaasclient "github.com/thalassa-cloud/client-go/iaas"
+	tcclient "github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/thalassa"
 )
 
 func TestSetMachineTypeField(t *testing.T) {
@@ -71,3 +81,264 @@ func TestSetMachineTypeField(t *testing.T) {
 		})
 	}
 }
+
+func TestRestartVirtualMachineInstanceForUpdate(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	status := "running"
+	rejectUpdate := false
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		call := r.Method + " " + r.URL.Path
+		switch call {
+		case "POST /v1/machines/vm-1/stop":
+			status = "stopped"
+		case "POST /v1/machines/vm-1/start":
+			status = "running"
+		case "PUT /v1/machines/vm-1":
+			var update iaasclient.UpdateMachine
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&update))
+			call = fmt.Sprintf("%s %s %s", call, *update.MachineType, *update.State)
+		}
+		if r.Method != http.MethodGet {
+			calls = append(calls, call)
+		}
+		if rejectUpdate && r.Method == http.MethodPut {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message":"machine type pgp-large is not available in this zone"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"identity":"vm-1","status":{"status":%q}}`, status)
+	}))
+	defer server.Close()
+
+	client, err := thalassa.NewClient(tcclient.WithBaseURL(server.URL), tcclient.WithAuthNone(), tcclient.WithOrganisation("org-test"))
+	assert.NoError(t, err)
+
+	running := iaasclient.MachineStateRunning
+	update := iaasclient.UpdateMachine{Name: "vm", MachineType: &[]string{"pgp-large"}[0], State: &running}
+	cause := errors.New("machine must be stopped to change the machine type")
+
+	t.Run("fail_if_restart_required does not restart", func(t *testing.T) {
+		_, err := restartVirtualMachineInstanceForUpdate(context.Background(), client, "vm-1", update, resizeStrategyFailIfRestartRequired, []string{"machine_type"}, cause)
+		assert.EqualError(t, err, `changing machine_type of virtual machine instance "vm-1" requires a restart, which resize_strategy "fail_if_restart_required" does not allow: machine must be stopped to change the machine type`)
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Empty(t, calls)
+	})
+
+	t.Run("stop_start stops, updates and starts the machine", func(t *testing.T) {
+		machine, err := restartVirtualMachineInstanceForUpdate(context.Background(), client, "vm-1", update, resizeStrategyStopStart, []string{"machine_type"}, cause)
+		assert.NoError(t, err)
+		assert.Equal(t, "running", machine.Status.Status)
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, []string{
+			"POST /v1/machines/vm-1/stop",
+			"PUT /v1/machines/vm-1 pgp-large stopped",
+			"POST /v1/machines/vm-1/start",
+		}, calls)
+	})
+
+	t.Run("stop_start starts the machine again when the update fails", func(t *testing.T) {
+		mu.Lock()
+		calls, rejectUpdate = nil, true
+		mu.Unlock()
+
+		_, err := restartVirtualMachineInstanceForUpdate(context.Background(), client, "vm-1", update, resizeStrategyStopStart, []string{"machine_type"}, cause)
+		assert.ErrorContains(t, err, "machine type pgp-large is not available in this zone")
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, []string{
+			"POST /v1/machines/vm-1/stop",
+			"PUT /v1/machines/vm-1 pgp-large stopped",
+			"POST /v1/machines/vm-1/start",
+		}, calls)
+		assert.Equal(t, "running", status)
+	})
+}
+
+func TestApplyMachineRestartWaitsForTransition(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	restarted, polls := false, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		transition := "2024-05-01T12:00:00Z"
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v1/machines/vm-1/restart":
+			restarted = true
+		case "GET /v1/machines/vm-1":
+			// the machine is still running with the old transition time when the restart is picked up
+			if restarted {
+				if polls++; polls > 1 {
+					transition = "2024-05-01T12:05:00Z"
+				}
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"identity":"vm-1","status":{"status":"running","lastTransitionTime":%q}}`, transition)
+	}))
+	defer server.Close()
+
+	client, err := thalassa.NewClient(tcclient.WithBaseURL(server.URL), tcclient.WithAuthNone(), tcclient.WithOrganisation("org-test"))
+	assert.NoError(t, err)
+
+	machine, err := applyMachinePowerOperation(context.Background(), client, "vm-1", machineRestartOperation)
+	assert.NoError(t, err)
+	assert.Equal(t, "running", machine.Status.Status)
+	assert.Equal(t, 5, machine.Status.LastTransitionTime.Minute())
+
+	mu.Lock()
+	defer mu.Unlock()
+	// the first poll after the restart is not accepted, the second sees the new transition and the third confirms it
+	assert.Equal(t, 3, polls)
+}
+
+func TestUpdateMachineKeepsErrorCode(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var update iaasclient.UpdateMachine
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&update))
+		w.Header().Set("Content-Type", "application/json")
+		switch *update.MachineType {
+		case "pgp-large":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code":"machine_must_be_stopped","message":"machine must be stopped to change the machine type"}`))
+		case "pgp-huge":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message":"machine type pgp-huge must be stopped before it can be used"}`))
+		default:
+			_, _ = fmt.Fprintf(w, `{"identity":"vm-1","machineType":{"slug":%q}}`, *update.MachineType)
+		}
+	}))
+	defer server.Close()
+
+	client, err := thalassa.NewClient(tcclient.WithBaseURL(server.URL), tcclient.WithAuthNone(), tcclient.WithOrganisation("org-test"))
+	assert.NoError(t, err)
+
+	update := func(machineType string) (*iaasclient.Machine, error) {
+		return updateMachine(context.Background(), client, "vm-1", iaasclient.UpdateMachine{Name: "vm", MachineType: &machineType})
+	}
+
+	machine, err := update("pgp-small")
+	assert.NoError(t, err)
+	assert.Equal(t, "pgp-small", machine.MachineType.Slug)
+
+	_, err = update("pgp-large")
+	assert.True(t, stoppedMachineRequired(err))
+	assert.True(t, tcclient.IsBadRequest(err))
+	assert.ErrorContains(t, err, "machine must be stopped to change the machine type")
+
+	// only the error code counts, not the message
+	_, err = update("pgp-huge")
+	assert.False(t, stoppedMachineRequired(err))
+	assert.True(t, tcclient.IsBadRequest(err))
+	assert.False(t, stoppedMachineRequired(errors.New("machine must be stopped")))
+}
+
+func TestRejectAvailabilityZoneMoveWithoutRestart(t *testing.T) {
+	t.Parallel()
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"availability_zone": {Type: schema.TypeString, Optional: true, Computed: true},
+			"resize_strategy":   {Type: schema.TypeString, Optional: true, Default: resizeStrategyStopStart},
+			"state":             {Type: schema.TypeString, Computed: true},
+		},
+		CustomizeDiff: rejectAvailabilityZoneMoveWithoutRestart,
+	}
+
+	tests := []struct {
+		name        string
+		state       string
+		strategy    string
+		zone        string
+		expectedErr bool
+	}{
+		{name: "stop_start allows moves", state: "running", strategy: resizeStrategyStopStart, zone: "nl-01b"},
+		{name: "unchanged zone is allowed", state: "running", strategy: resizeStrategyFailIfRestartRequired, zone: "nl-01a"},
+		{name: "stopped machines can be moved", state: "stopped", strategy: resizeStrategyFailIfRestartRequired, zone: "nl-01b"},
+		{name: "running machines cannot be moved", state: "running", strategy: resizeStrategyFailIfRestartRequired, zone: "nl-01b", expectedErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			state := &terraform.InstanceState{ID: "vm-1", Attributes: map[string]string{
+				"id":                "vm-1",
+				"availability_zone": "nl-01a",
+				"resize_strategy":   tt.strategy,
+				"state":             tt.state,
+			}}
+			config := terraform.NewResourceConfigRaw(map[string]any{"availability_zone": tt.zone, "resize_strategy": tt.strategy})
+
+			_, err := resource.Diff(context.Background(), state, config, nil)
+			if tt.expectedErr {
+				assert.ErrorContains(t, err, "availability_zone of a running virtual machine instance cannot be changed")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVirtualMachineInstancePowerOperation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		config    map[string]any
+		state     iaasclient.MachineState
+		operation string
+	}{
+		{
+			name:      "stops a running machine",
+			config:    map[string]any{"desired_state": "stopped"},
+			state:     iaasclient.MachineStateRunning,
+			operation: "stop",
+		},
+		{
+			name:      "starts a stopped machine",
+			config:    map[string]any{"desired_state": "running"},
+			state:     iaasclient.MachineStateStopped,
+			operation: "start",
+		},
+		{
+			name:   "machine already in the desired state",
+			config: map[string]any{"desired_state": "stopped"},
+			state:  iaasclient.MachineStateStopped,
+		},
+		{
+			name:      "restart triggers restart a running machine",
+			config:    map[string]any{"restart_triggers": map[string]any{"cloud_init": "abc123"}},
+			state:     iaasclient.MachineStateRunning,
+			operation: "restart",
+		},
+		{
+			name:   "restart triggers do not start a stopped machine",
+			config: map[string]any{"restart_triggers": map[string]any{"cloud_init": "abc123"}},
+			state:  iaasclient.MachineStateStopped,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, resourceVirtualMachineInstance().Schema, tt.config)
+			operation, ok := virtualMachineInstancePowerOperation(d, tt.state)
+			assert.Equal(t, tt.operation != "", ok)
+			assert.Equal(t, tt.operation, operation.name)
+		})
+	}
+}
@@ -24,6 +24,10 @@ func resourceBlockVolumeAttachment() *schema.Resource {
 		ReadContext:   resourceBlockVolumeAttachmentRead,
 		UpdateContext: resourceBlockVolumeAttachmentUpdate,
 		DeleteContext: resourceBlockVolumeAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -35,6 +39,12 @@ func resourceBlockVolumeAttachment() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation of the Volume Attachment. If not provided, the organisation of the (Terraform) provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"volume_id": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -64,14 +74,16 @@ func resourceBlockVolumeAttachment() *schema.Resource {
 				Optional:    true,
 				Default:     5,
 				ForceNew:    false,
-				Description: "The timeout in minutes to wait for the volume to be attached to the virtual machine. Only used if wait_for_attached is true. If not provided, the default timeout of 5 minutes will be used.",
+				Deprecated:  provider.DeprecatedTimeoutMessage("create"),
+				Description: "The timeout in minutes to wait for a previous detachment of the volume to complete and, if wait_for_attached is true, for the volume to be attached to the virtual machine.",
 			},
 			"wait_for_detached_timeout": {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				ForceNew:    false,
 				Default:     5,
-				Description: "The timeout in minutes to wait for the volume to be detached from the virtual machine. Only used if wait_for_detached is true. If not provided, the default timeout of 5 minutes will be used.",
+				Deprecated:  provider.DeprecatedTimeoutMessage("delete"),
+				Description: "The timeout in minutes to wait for the volume to be detached from the virtual machine. Only used if wait_for_detached is true.",
 			},
 			"wait_for_detached": {
 				Type:        schema.TypeBool,
@@ -116,28 +128,11 @@ func resourceBlockVolumeAttachmentCreate(ctx context.Context, d *schema.Resource
 
 	switch volume.Status {
 	case "Detaching":
-		// wait until detaching is complete
-		timeout := d.Get("wait_for_detached_timeout").(int)
-		ctxWithTimeout, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Minute)
+		// wait until detaching is complete, as part of the create timeout
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, provider.Timeout(d, schema.TimeoutCreate, "wait_for_attached_timeout", 5))
 		defer cancel()
-		for {
-			select {
-			case <-ctxWithTimeout.Done():
-				return diag.FromErr(fmt.Errorf("timeout waiting for volume to be available"))
-			default:
-			}
-			volume, err = client.IaaS().GetVolume(ctxWithTimeout, volumeID)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("error getting volume: %s", err))
-			}
-			if volume.Status == "Available" {
-				break
-			} else if volume.Status == "Attaching" {
-				return diag.FromErr(fmt.Errorf("volume is already being attached to a different virtual machine"))
-			} else if volume.Status == "Attached" {
-				return diag.FromErr(fmt.Errorf("volume is already attached to a different virtual machine"))
-			}
-			time.Sleep(1 * time.Second)
+		if _, err := waitForBlockVolumeStatus(ctxWithTimeout, client, volumeID, "Available", "Detaching"); err != nil {
+			return diag.FromErr(err)
 		}
 	case "Attached":
 		// check if the volume is already attached to the VMI
@@ -160,23 +155,10 @@ func resourceBlockVolumeAttachmentCreate(ctx context.Context, d *schema.Resource
 
 	if d.Get("wait_for_attached").(bool) {
 		// wait until the volume is attached
-		timeout := d.Get("wait_for_attached_timeout").(int)
-		ctxWithTimeout, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Minute)
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, provider.Timeout(d, schema.TimeoutCreate, "wait_for_attached_timeout", 5))
 		defer cancel()
-		for {
-			select {
-			case <-ctxWithTimeout.Done():
-				return diag.FromErr(fmt.Errorf("timeout waiting for volume to be attached"))
-			default:
-			}
-			volume, err = client.IaaS().GetVolume(ctxWithTimeout, volumeID)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("error getting volume: %s", err))
-			}
-			if volume.Status == "Attached" {
-				break
-			}
-			time.Sleep(1 * time.Second)
+		if _, err := waitForBlockVolumeStatus(ctxWithTimeout, client, volumeID, "Attached"); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -299,27 +281,10 @@ func resourceBlockVolumeAttachmentDelete(ctx context.Context, d *schema.Resource
 
 	if d.Get("wait_for_detached").(bool) {
 		// wait until the volume is detached
-		timeout := d.Get("wait_for_detached_timeout").(int)
-		ctxWithTimeout, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Minute)
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, provider.Timeout(d, schema.TimeoutDelete, "wait_for_detached_timeout", 5))
 		defer cancel()
-		for {
-			select {
-			case <-ctxWithTimeout.Done():
-				return diag.FromErr(fmt.Errorf("timeout waiting for volume to be detached"))
-			default:
-			}
-			volume, err := client.IaaS().GetVolume(ctxWithTimeout, volumeID)
-			if err != nil {
-				if tcclient.IsNotFound(err) {
-					d.SetId("")
-					return nil
-				}
-				return diag.FromErr(fmt.Errorf("error getting volume: %s", err))
-			}
-			if volume.Status == "Available" {
-				break
-			}
-			time.Sleep(1 * time.Second)
+		if err := waitForDetachedBlockVolume(ctxWithTimeout, client, volumeID); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 	return nil
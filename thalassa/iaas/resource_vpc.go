@@ -15,7 +15,7 @@ import (
 )
 
 func resourceVpc() *schema.Resource {
-	return &schema.Resource{
+	return provider.WithIDIdentity(&schema.Resource{
 		Description:   "Create an vpc",
 		CreateContext: resourceVpcCreate,
 		ReadContext:   resourceVpcRead,
@@ -32,6 +32,12 @@ func resourceVpc() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation of the Vpc. If not provided, the organisation of the (Terraform) provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -87,7 +93,7 @@ func resourceVpc() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-	}
+	})
 }
 
 func resourceVpcCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
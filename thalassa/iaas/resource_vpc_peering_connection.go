@@ -23,6 +23,10 @@ func resourceVpcPeeringConnection() *schema.Resource {
 		ReadContext:   resourceVpcPeeringConnectionRead,
 		UpdateContext: resourceVpcPeeringConnectionUpdate,
 		DeleteContext: resourceVpcPeeringConnectionDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -206,12 +210,14 @@ func resourceVpcPeeringConnection() *schema.Resource {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				Default:     10,
+				Deprecated:  provider.DeprecatedTimeoutMessage("create"),
 				Description: "The timeout in minutes to wait for the VPC peering connection to be active",
 			},
 			"wait_for_deleted_timeout": {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				Default:     5,
+				Deprecated:  provider.DeprecatedTimeoutMessage("delete"),
 				Description: "The timeout in minutes to wait for the VPC peering connection to be deleted. Set to 0 to disable waiting.",
 			},
 		},
@@ -246,11 +252,10 @@ func resourceVpcPeeringConnectionCreate(ctx context.Context, d *schema.ResourceD
 	d.SetId(peeringConnection.Identity)
 
 	if d.Get("wait_for_active").(bool) {
-		timeout := d.Get("wait_for_active_timeout").(int)
-		ctxWithTimeout, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Minute)
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, provider.Timeout(d, schema.TimeoutCreate, "wait_for_active_timeout", 10))
 		defer cancel()
-		if diag := waitForVpcPeeringConnectionActive(ctxWithTimeout, client.IaaS(), peeringConnection.Identity); diag != nil {
-			return diag
+		if err := waitForVpcPeeringConnectionActive(ctxWithTimeout, client, peeringConnection.Identity); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -328,23 +333,12 @@ func resourceVpcPeeringConnectionDelete(ctx context.Context, d *schema.ResourceD
 	}
 
 	// wait until the peering connection is deleted
-	timeout := d.Get("wait_for_deleted_timeout").(int)
+	timeout := provider.Timeout(d, schema.TimeoutDelete, "wait_for_deleted_timeout", 5)
 	if timeout > 0 {
-		ctxWithTimeout, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Minute)
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, timeout)
 		defer cancel()
-		for {
-			select {
-			case <-ctxWithTimeout.Done():
-				return diag.FromErr(fmt.Errorf("timeout while waiting for peering connection to be deleted"))
-			case <-time.After(1 * time.Second):
-			}
-			_, err := client.IaaS().GetVpcPeeringConnection(ctxWithTimeout, d.Id())
-			if err != nil {
-				if tcclient.IsNotFound(err) {
-					break
-				}
-				return diag.FromErr(err)
-			}
+		if err := waitForDeletedVpcPeeringConnection(ctxWithTimeout, client, d.Id()); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 	d.SetId("")
@@ -21,6 +21,10 @@ func resourceVpcPeeringConnectionAcceptance() *schema.Resource {
 		ReadContext:   resourceVpcPeeringConnectionAcceptanceRead,
 		UpdateContext: updateVpcPeeringConnectionAcceptance,
 		DeleteContext: resourceVpcPeeringConnectionAcceptanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -53,12 +57,14 @@ func resourceVpcPeeringConnectionAcceptance() *schema.Resource {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				Default:     10,
+				Deprecated:  provider.DeprecatedTimeoutMessage("create"),
 				Description: "The timeout in minutes to wait for the VPC peering connection to be active",
 			},
 			"wait_for_deleted_timeout": {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				Default:     5,
+				Deprecated:  provider.DeprecatedTimeoutMessage("delete"),
 				Description: "The timeout in minutes to wait for the VPC peering connection to be deleted. Set to 0 to disable waiting.",
 			},
 		},
@@ -98,11 +104,10 @@ func resourceVpcPeeringConnectionAcceptanceCreate(ctx context.Context, d *schema
 	d.SetId(peeringConnectionId)
 
 	if d.Get("wait_for_active").(bool) {
-		timeout := d.Get("wait_for_active_timeout").(int)
-		ctxWithTimeout, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Minute)
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, provider.Timeout(d, schema.TimeoutCreate, "wait_for_active_timeout", 10))
 		defer cancel()
-		if diag := waitForVpcPeeringConnectionActive(ctxWithTimeout, client.IaaS(), peeringConnectionId); diag != nil {
-			return diag
+		if err := waitForVpcPeeringConnectionActive(ctxWithTimeout, client, peeringConnectionId); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 	return setVpcPeeringConnectionAcceptanceData(d, peeringConnection)
@@ -169,23 +174,12 @@ func resourceVpcPeeringConnectionAcceptanceDelete(ctx context.Context, d *schema
 	}
 
 	// wait until the peering connection is deleted
-	timeout := d.Get("wait_for_deleted_timeout").(int)
+	timeout := provider.Timeout(d, schema.TimeoutDelete, "wait_for_deleted_timeout", 5)
 	if timeout > 0 {
-		ctxWithTimeout, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Minute)
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, timeout)
 		defer cancel()
-		for {
-			select {
-			case <-ctxWithTimeout.Done():
-				return diag.FromErr(fmt.Errorf("timeout while waiting for peering connection to be deleted"))
-			case <-time.After(1 * time.Second):
-			}
-			_, err := client.IaaS().GetVpcPeeringConnection(ctxWithTimeout, peeringConnectionId)
-			if err != nil {
-				if tcclient.IsNotFound(err) {
-					break
-				}
-				return diag.FromErr(err)
-			}
+		if err := waitForDeletedVpcPeeringConnection(ctxWithTimeout, client, peeringConnectionId); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -202,33 +196,3 @@ func setVpcPeeringConnectionAcceptanceData(d *schema.ResourceData, connection *i
 	}
 	return nil
 }
-
-func waitForVpcPeeringConnectionActive(ctx context.Context, client *iaas.Client, peeringConnectionId string) diag.Diagnostics {
-	for {
-		select {
-		case <-ctx.Done():
-			return diag.FromErr(fmt.Errorf("timeout waiting for VPC peering connection to be active"))
-		case <-time.After(1 * time.Second):
-		}
-		peeringConnection, err := client.GetVpcPeeringConnection(ctx, peeringConnectionId)
-		if err != nil {
-			if tcclient.IsNotFound(err) {
-				return diag.FromErr(fmt.Errorf("VPC peering connection %s was not found", peeringConnectionId))
-			}
-			return diag.FromErr(fmt.Errorf("failed to get VPC peering connection %s: %w", peeringConnectionId, err))
-		}
-		if peeringConnection == nil {
-			return diag.FromErr(fmt.Errorf("failed to get VPC peering connection %s: %w", peeringConnectionId, err))
-		}
-		if strings.EqualFold(string(peeringConnection.Status), "active") {
-			break
-		}
-		if strings.EqualFold(string(peeringConnection.Status), "rejected") {
-			return diag.FromErr(fmt.Errorf("VPC peering connection %s was rejected", peeringConnectionId))
-		}
-		if strings.EqualFold(string(peeringConnection.Status), "failed") {
-			return diag.FromErr(fmt.Errorf("VPC peering connection %s failed", peeringConnectionId))
-		}
-	}
-	return nil
-}
@@ -1,6 +1,11 @@
 package iaas
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
 
 var (
 	ResourcesMap = map[string]*schema.Resource{
@@ -30,24 +35,55 @@ var (
 	}
 
 	DataSourcesMap = map[string]*schema.Resource{
-		"thalassa_region":                  DataSourceRegion(),
-		"thalassa_regions":                 DataSourceRegions(),
-		"thalassa_machine_image":           DataSourceMachineImage(),
-		"thalassa_machine_type":            DataSourceMachineType(),
-		"thalassa_vpc":                     DataSourceVpc(),
-		"thalassa_vpc_default_route_table": DataSourceVpcDefaultRouteTable(),
-		"thalassa_vpc_peering_connection":  DataSourceVpcPeeringConnection(),
-		"thalassa_vpc_peering_connections": DataSourceVpcPeeringConnections(),
-		"thalassa_vpc_firewall_rule":       DataSourceVpcFirewallRule(),
-		"thalassa_route_table":             DataSourceRouteTable(),
-		"thalassa_vpc_firewall_rules":      DataSourceVpcFirewallRules(),
-		"thalassa_security_group":          DataSourceSecurityGroup(),
-		"thalassa_volume_type":             DataSourceVolumeType(),
-		"thalassa_subnet":                  dataSourceSubnet(),
-		"thalassa_natgateway":              DataSourceNatGateway(),
-		"thalassa_loadbalancer":            DataSourceLoadBalancer(),
-		"thalassa_snapshot":                DataSourceSnapshot(),
-		"thalassa_snapshot_policy":         DataSourceSnapshotPolicy(),
-		"thalassa_cloud_init_template":     dataSourceCloudInitTemplate(),
+		"thalassa_region":                         DataSourceRegion(),
+		"thalassa_regions":                        DataSourceRegions(),
+		"thalassa_machine_image":                  DataSourceMachineImage(),
+		"thalassa_machine_type":                   DataSourceMachineType(),
+		"thalassa_vpc":                            DataSourceVpc(),
+		"thalassa_vpc_default_route_table":        DataSourceVpcDefaultRouteTable(),
+		"thalassa_vpc_peering_connection":         DataSourceVpcPeeringConnection(),
+		"thalassa_vpc_peering_connections":        DataSourceVpcPeeringConnections(),
+		"thalassa_vpc_firewall_rule":              DataSourceVpcFirewallRule(),
+		"thalassa_route_table":                    DataSourceRouteTable(),
+		"thalassa_vpc_firewall_rules":             DataSourceVpcFirewallRules(),
+		"thalassa_security_group":                 DataSourceSecurityGroup(),
+		"thalassa_volume_type":                    DataSourceVolumeType(),
+		"thalassa_subnet":                         dataSourceSubnet(),
+		"thalassa_natgateway":                     DataSourceNatGateway(),
+		"thalassa_loadbalancer":                   DataSourceLoadBalancer(),
+		"thalassa_snapshot":                       DataSourceSnapshot(),
+		"thalassa_snapshot_policy":                DataSourceSnapshotPolicy(),
+		"thalassa_cloud_init_template":            dataSourceCloudInitTemplate(),
+		"thalassa_cloud_init_config":              DataSourceCloudInitConfig(),
+		"thalassa_vpcs":                           DataSourceVpcs(),
+		"thalassa_subnets":                        DataSourceSubnets(),
+		"thalassa_virtual_machine_instances":      DataSourceVirtualMachineInstances(),
+		"thalassa_virtual_machine_console_output": DataSourceVirtualMachineConsoleOutput(),
+		"thalassa_block_volumes":                  DataSourceBlockVolumes(),
+		"thalassa_loadbalancers":                  DataSourceLoadBalancers(),
+		"thalassa_security_groups":                DataSourceSecurityGroups(),
+		"thalassa_reserved_ips":                   DataSourceReservedIPs(),
+		"thalassa_snapshots":                      DataSourceSnapshots(),
+	}
+
+	Actions = []func() action.Action{
+		NewMachineStartAction,
+		NewMachineStopAction,
+		NewMachineRestartAction,
+	}
+
+	Functions = []func() function.Function{
+		NewSubnetCIDRsFunction,
+	}
+
+	ListResources = []func() list.ListResource{
+		NewVpcListResource,
+		NewSubnetListResource,
+		NewSecurityGroupListResource,
+		NewRouteTableListResource,
+		NewNatGatewayListResource,
+		NewLoadBalancerListResource,
+		NewVirtualMachineInstanceListResource,
+		NewBlockVolumeListResource,
 	}
 )
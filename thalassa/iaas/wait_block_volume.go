@@ -0,0 +1,70 @@
+package iaas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thalassa-cloud/client-go/iaas"
+	"github.com/thalassa-cloud/client-go/thalassa"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/waiter"
+)
+
+func blockVolumeWaiter(client thalassa.Client, volumeID string) waiter.Waiter[*iaas.Volume] {
+	return waiter.Waiter[*iaas.Volume]{
+		Description: fmt.Sprintf("block volume %q", volumeID),
+		Refresh: func(ctx context.Context) (*iaas.Volume, error) {
+			return client.IaaS().GetVolume(ctx, volumeID)
+		},
+		State: func(volume *iaas.Volume) string { return volume.Status },
+	}
+}
+
+func waitForReadyBlockVolume(ctx context.Context, client thalassa.Client, volumeID string) (*iaas.Volume, error) {
+	w := blockVolumeWaiter(client, volumeID)
+	w.Target = []string{"available", "ready"}
+	return w.Wait(ctx)
+}
+
+// waitForBlockVolumeStatus waits until the block volume has the given status. When pending statuses are given, any
+// other status ends the wait with an error.
+func waitForBlockVolumeStatus(ctx context.Context, client thalassa.Client, volumeID, status string, pending ...string) (*iaas.Volume, error) {
+	w := blockVolumeWaiter(client, volumeID)
+	w.Target = []string{status}
+	w.Pending = pending
+	return w.Wait(ctx)
+}
+
+// waitForDetachedBlockVolume waits until the block volume is available again. A deleted volume counts as detached.
+func waitForDetachedBlockVolume(ctx context.Context, client thalassa.Client, volumeID string) error {
+	w := blockVolumeWaiter(client, volumeID)
+	w.Target = []string{"available"}
+	w.TargetNotFound = true
+	_, err := w.Wait(ctx)
+	return err
+}
+
+func waitForDeletedBlockVolume(ctx context.Context, client thalassa.Client, volumeID string) error {
+	w := blockVolumeWaiter(client, volumeID)
+	w.Target = []string{"deleted"}
+	w.Failed = []string{}
+	w.TargetNotFound = true
+	_, err := w.Wait(ctx)
+	return err
+}
+
+// waitForBlockVolumeSize waits until the block volume reports at least the given size after it was expanded.
+func waitForBlockVolumeSize(ctx context.Context, client thalassa.Client, volumeID string, sizeGB int) (*iaas.Volume, error) {
+	w := blockVolumeWaiter(client, volumeID)
+	w.State = func(volume *iaas.Volume) string {
+		if volume.Size >= sizeGB {
+			return "expanded"
+		}
+		return volume.Status
+	}
+	w.Message = func(volume *iaas.Volume) string {
+		return fmt.Sprintf("size is %d GB, expanding to %d GB", volume.Size, sizeGB)
+	}
+	w.Target = []string{"expanded"}
+	return w.Wait(ctx)
+}
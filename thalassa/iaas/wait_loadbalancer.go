@@ -0,0 +1,36 @@
+package iaas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thalassa-cloud/client-go/iaas"
+	"github.com/thalassa-cloud/client-go/thalassa"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/waiter"
+)
+
+func loadbalancerWaiter(client thalassa.Client, loadbalancerID string) waiter.Waiter[*iaas.VpcLoadbalancer] {
+	return waiter.Waiter[*iaas.VpcLoadbalancer]{
+		Description: fmt.Sprintf("loadbalancer %q", loadbalancerID),
+		Refresh: func(ctx context.Context) (*iaas.VpcLoadbalancer, error) {
+			return client.IaaS().GetLoadbalancer(ctx, loadbalancerID)
+		},
+		State: func(loadbalancer *iaas.VpcLoadbalancer) string { return loadbalancer.Status },
+	}
+}
+
+func waitForReadyLoadbalancer(ctx context.Context, client thalassa.Client, loadbalancerID string) (*iaas.VpcLoadbalancer, error) {
+	w := loadbalancerWaiter(client, loadbalancerID)
+	w.Target = []string{"ready"}
+	return w.Wait(ctx)
+}
+
+func waitForDeletedLoadbalancer(ctx context.Context, client thalassa.Client, loadbalancerID string) error {
+	w := loadbalancerWaiter(client, loadbalancerID)
+	w.Target = []string{"deleted"}
+	w.Failed = []string{}
+	w.TargetNotFound = true
+	_, err := w.Wait(ctx)
+	return err
+}
@@ -0,0 +1,46 @@
+package iaas
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/thalassa-cloud/client-go/iaas"
+	"github.com/thalassa-cloud/client-go/thalassa"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/waiter"
+)
+
+// natGatewayAvailable is the wait state of a NAT gateway that has been assigned an endpoint IP.
+const natGatewayAvailable = "available"
+
+func natGatewayWaiter(client thalassa.Client, natGatewayID string) waiter.Waiter[*iaas.VpcNatGateway] {
+	return waiter.Waiter[*iaas.VpcNatGateway]{
+		Description: fmt.Sprintf("NAT gateway %q", natGatewayID),
+		Refresh: func(ctx context.Context) (*iaas.VpcNatGateway, error) {
+			return client.IaaS().GetNatGateway(ctx, natGatewayID)
+		},
+		State: func(natGateway *iaas.VpcNatGateway) string { return natGateway.Status },
+	}
+}
+
+// waitForAvailableNatGateway waits until the NAT gateway has an endpoint IP.
+func waitForAvailableNatGateway(ctx context.Context, client thalassa.Client, natGatewayID string) (*iaas.VpcNatGateway, error) {
+	w := natGatewayWaiter(client, natGatewayID)
+	w.State = func(natGateway *iaas.VpcNatGateway) string {
+		if strings.TrimSpace(natGateway.EndpointIP) != "" {
+			return natGatewayAvailable
+		}
+		return natGateway.Status
+	}
+	w.Target = []string{natGatewayAvailable}
+	return w.Wait(ctx)
+}
+
+func waitForDeletedNatGateway(ctx context.Context, client thalassa.Client, natGatewayID string) error {
+	w := natGatewayWaiter(client, natGatewayID)
+	w.Target = []string{"deleted"}
+	w.TargetNotFound = true
+	_, err := w.Wait(ctx)
+	return err
+}
@@ -0,0 +1,37 @@
+package iaas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thalassa-cloud/client-go/iaas"
+	"github.com/thalassa-cloud/client-go/thalassa"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/waiter"
+)
+
+func snapshotWaiter(client thalassa.Client, snapshotID string) waiter.Waiter[*iaas.Snapshot] {
+	return waiter.Waiter[*iaas.Snapshot]{
+		Description: fmt.Sprintf("snapshot %q", snapshotID),
+		Refresh: func(ctx context.Context) (*iaas.Snapshot, error) {
+			return client.IaaS().GetSnapshot(ctx, snapshotID)
+		},
+		State:  func(snapshot *iaas.Snapshot) string { return string(snapshot.Status) },
+		Failed: []string{string(iaas.SnapshotStatusFailed)},
+	}
+}
+
+func waitForAvailableSnapshot(ctx context.Context, client thalassa.Client, snapshotID string) error {
+	w := snapshotWaiter(client, snapshotID)
+	w.Target = []string{string(iaas.SnapshotStatusAvailable)}
+	_, err := w.Wait(ctx)
+	return err
+}
+
+func waitForDeletedSnapshot(ctx context.Context, client thalassa.Client, snapshotID string) error {
+	w := snapshotWaiter(client, snapshotID)
+	w.Target = []string{string(iaas.SnapshotStatusDeleted)}
+	w.TargetNotFound = true
+	_, err := w.Wait(ctx)
+	return err
+}
@@ -0,0 +1,54 @@
+package iaas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thalassa-cloud/client-go/iaas"
+	tcclient "github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/thalassa"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/waiter"
+)
+
+func subnetStatus(subnet *iaas.Subnet) string {
+	return string(subnet.Status)
+}
+
+func waitForReadySubnet(ctx context.Context, client thalassa.Client, subnetID string) (*iaas.Subnet, error) {
+	return waiter.Waiter[*iaas.Subnet]{
+		Description: fmt.Sprintf("subnet %q", subnetID),
+		Refresh: func(ctx context.Context) (*iaas.Subnet, error) {
+			return client.IaaS().GetSubnet(ctx, subnetID)
+		},
+		State:  subnetStatus,
+		Target: []string{string(iaas.SubnetStatusReady)},
+		Failed: []string{string(iaas.SubnetStatusFailed), string(iaas.SubnetStatusDeleting), string(iaas.SubnetStatusDeleted)},
+	}.Wait(ctx)
+}
+
+func waitForDeletedSubnet(ctx context.Context, client thalassa.Client, subnetID string) error {
+	_, err := waiter.Waiter[*iaas.Subnet]{
+		Description: fmt.Sprintf("subnet %q", subnetID),
+		Refresh: func(ctx context.Context) (*iaas.Subnet, error) {
+			subnet, err := client.IaaS().GetSubnet(ctx, subnetID)
+			if err != nil || subnet == nil {
+				return subnet, err
+			}
+			switch subnet.Status {
+			case iaas.SubnetStatusReady, iaas.SubnetStatusActive:
+				// The delete API can return before the subnet transitions to deleting.
+				if err := client.IaaS().DeleteSubnet(ctx, subnetID); err != nil && !tcclient.IsNotFound(err) {
+					return nil, err
+				}
+			}
+			return subnet, nil
+		},
+		State:          subnetStatus,
+		Target:         []string{string(iaas.SubnetStatusDeleted)},
+		Pending:        []string{string(iaas.SubnetStatusDeleting), string(iaas.SubnetStatusReady), string(iaas.SubnetStatusActive)},
+		Failed:         []string{string(iaas.SubnetStatusFailed)},
+		TargetNotFound: true,
+	}.Wait(ctx)
+	return err
+}
@@ -0,0 +1,66 @@
+package iaas
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/thalassa-cloud/client-go/iaas"
+	"github.com/thalassa-cloud/client-go/thalassa"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/waiter"
+)
+
+func machineWaiter(client thalassa.Client, machineID string) waiter.Waiter[*iaas.Machine] {
+	return waiter.Waiter[*iaas.Machine]{
+		Description: fmt.Sprintf("virtual machine instance %q", machineID),
+		Refresh: func(ctx context.Context) (*iaas.Machine, error) {
+			return client.IaaS().GetMachine(ctx, machineID)
+		},
+		State:   func(machine *iaas.Machine) string { return machine.Status.Status },
+		Message: func(machine *iaas.Machine) string { return machine.Status.StatusMessage },
+	}
+}
+
+// waitForMachineStatus waits until the status of the machine matches one of the target statuses.
+func waitForMachineStatus(ctx context.Context, client thalassa.Client, machineID string, targetStatuses []string) (*iaas.Machine, error) {
+	w := machineWaiter(client, machineID)
+	w.Target = targetStatuses
+	return w.Wait(ctx)
+}
+
+// machineStatusTransitioned is the state reported by waitForMachineStatusTransition once the machine has transitioned.
+const machineStatusTransitioned = "transitioned"
+
+// waitForMachineStatusTransition waits until the machine has left the target statuses, or its status transitioned
+// after since, and then until it is in one of the target statuses again. Operations such as restarts keep the machine
+// in a target status until the region picks them up, so an immediate poll would accept the machine before the
+// operation even started.
+func waitForMachineStatusTransition(ctx context.Context, client thalassa.Client, machineID string, since time.Time, targetStatuses []string) (*iaas.Machine, error) {
+	w := machineWaiter(client, machineID)
+	w.State = func(machine *iaas.Machine) string {
+		inTarget := slices.ContainsFunc(targetStatuses, func(status string) bool {
+			return strings.EqualFold(status, machine.Status.Status)
+		})
+		if !inTarget || machine.Status.LastTransitionTime.After(since) {
+			return machineStatusTransitioned
+		}
+		return machine.Status.Status
+	}
+	w.Target = []string{machineStatusTransitioned}
+	if _, err := w.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return waitForMachineStatus(ctx, client, machineID, targetStatuses)
+}
+
+func waitForDeletedMachine(ctx context.Context, client thalassa.Client, machineID string) error {
+	w := machineWaiter(client, machineID)
+	w.Target = []string{"deleted"}
+	w.Failed = []string{}
+	w.TargetNotFound = true
+	_, err := w.Wait(ctx)
+	return err
+}
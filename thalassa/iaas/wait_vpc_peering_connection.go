@@ -0,0 +1,43 @@
+package iaas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thalassa-cloud/client-go/iaas"
+	"github.com/thalassa-cloud/client-go/thalassa"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/waiter"
+)
+
+func vpcPeeringConnectionWaiter(client thalassa.Client, peeringConnectionID string) waiter.Waiter[*iaas.VpcPeeringConnection] {
+	return waiter.Waiter[*iaas.VpcPeeringConnection]{
+		Description: fmt.Sprintf("VPC peering connection %q", peeringConnectionID),
+		Refresh: func(ctx context.Context) (*iaas.VpcPeeringConnection, error) {
+			return client.IaaS().GetVpcPeeringConnection(ctx, peeringConnectionID)
+		},
+		State: func(connection *iaas.VpcPeeringConnection) string { return string(connection.Status) },
+		Message: func(connection *iaas.VpcPeeringConnection) string {
+			if connection.StatusMessage == nil {
+				return ""
+			}
+			return *connection.StatusMessage
+		},
+	}
+}
+
+func waitForVpcPeeringConnectionActive(ctx context.Context, client thalassa.Client, peeringConnectionID string) error {
+	w := vpcPeeringConnectionWaiter(client, peeringConnectionID)
+	w.Target = []string{"active"}
+	w.Failed = []string{"rejected", "failed"}
+	_, err := w.Wait(ctx)
+	return err
+}
+
+func waitForDeletedVpcPeeringConnection(ctx context.Context, client thalassa.Client, peeringConnectionID string) error {
+	w := vpcPeeringConnectionWaiter(client, peeringConnectionID)
+	w.Failed = []string{}
+	w.TargetNotFound = true
+	_, err := w.Wait(ctx)
+	return err
+}
@@ -3,6 +3,8 @@ package iam
 import (
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
 	iam "github.com/thalassa-cloud/client-go/iam"
 )
 
@@ -36,3 +38,34 @@ func toListOfInterfaces(value []string) []any {
 	}
 	return result
 }
+
+func expandAccessCredentialsScopes(value any) []iam.AccessCredentialsScope {
+	scopes := make([]iam.AccessCredentialsScope, 0)
+	if s, ok := value.([]any); ok {
+		for _, scope := range s {
+			scopes = append(scopes, iam.AccessCredentialsScope(scope.(string)))
+		}
+	}
+	return scopes
+}
+
+func flattenAccessCredentialsScopes(scopes []iam.AccessCredentialsScope) []any {
+	result := make([]any, len(scopes))
+	for i, scope := range scopes {
+		result[i] = string(scope)
+	}
+	return result
+}
+
+// suppressEquivalentRFC3339 suppresses diffs between timestamps that denote the same instant in different time zones.
+func suppressEquivalentRFC3339(_, old, new string, _ *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
@@ -0,0 +1,359 @@
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	validate "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+
+	iam "github.com/thalassa-cloud/client-go/iam"
+	tcclient "github.com/thalassa-cloud/client-go/pkg/client"
+)
+
+func ResourceFederatedIdentity() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Bind a subject of a federated identity provider to a service account, allowing its OIDC tokens to be exchanged for Thalassa Cloud access tokens",
+		CreateContext: resourceFederatedIdentityCreate,
+		ReadContext:   resourceFederatedIdentityRead,
+		UpdateContext: resourceFederatedIdentityUpdate,
+		DeleteContext: resourceFederatedIdentityDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"organisation_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Organisation of the federated identity. If not provided, the organisation of the (Terraform) provider will be used.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.StringLenBetween(1, 255),
+				Description:  "Name of the federated identity",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.StringLenBetween(0, 255),
+				Description:  "Description of the federated identity",
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Labels for the federated identity",
+			},
+			"annotations": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Annotations for the federated identity",
+			},
+			"service_account_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identity of the service account the federated identity acts as",
+			},
+			"provider_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identity of the federated identity provider that issues the tokens",
+			},
+			"subject": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StringIsNotEmpty,
+				Description:  "Subject of the OIDC tokens, matching the `sub` claim (e.g. `repo:my-org/my-repo:ref:refs/heads/main`)",
+			},
+			"trusted_audiences": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Audiences the OIDC token must contain, matching the `aud` claim",
+			},
+			"audience_match_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(iam.AudienceMatchModeAny),
+				ValidateFunc: validate.StringInSlice([]string{string(iam.AudienceMatchModeExact), string(iam.AudienceMatchModeAny), string(iam.AudienceMatchModeAll)}, false),
+				Description:  "How the token audiences are matched against `trusted_audiences`: `exact`, `any` or `all`. Defaults to `any`.",
+			},
+			"allowed_scopes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Scopes granted to access tokens obtained through this federated identity",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validate.StringInSlice([]string{
+						"api:read",
+						"api:write",
+						"kubernetes",
+						"objectStorage",
+					}, false),
+				},
+			},
+			"conditions": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validate.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				Description:      "JSON encoded claim conditions the token must satisfy, for example `jsonencode({ ref_protected = true, environment = \"production\" })`",
+			},
+			"expires_at": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validate.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339,
+				Description:      "Expiration timestamp of the federated identity (RFC3339 format). If not set, it never expires.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the federated identity can be used. Defaults to `true`.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the federated identity: `active`, `inactive`, `expired` or `revoked`",
+			},
+			"last_used_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last used timestamp of the federated identity",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation timestamp of the federated identity",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last update timestamp of the federated identity",
+			},
+			"object_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Object version of the federated identity",
+			},
+		},
+	}
+}
+
+func resourceFederatedIdentityCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	expiresAt, err := parseOptionalTime(d.Get("expires_at").(string))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("invalid expires_at format: %w", err))
+	}
+	conditions, err := expandConditions(d.Get("conditions").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createReq := iam.CreateFederatedIdentityRequest{
+		Name:                   d.Get("name").(string),
+		Description:            d.Get("description").(string),
+		Labels:                 convert.ConvertToMap(d.Get("labels")),
+		Annotations:            convert.ConvertToMap(d.Get("annotations")),
+		ServiceAccountIdentity: d.Get("service_account_id").(string),
+		ProviderIdentity:       d.Get("provider_id").(string),
+		ProviderSubject:        d.Get("subject").(string),
+		TrustedAudiences:       convert.ConvertToStringSlice(d.Get("trusted_audiences")),
+		AudienceMatchMode:      iam.AudienceMatchMode(d.Get("audience_match_mode").(string)),
+		AllowedScopes:          expandAccessCredentialsScopes(d.Get("allowed_scopes")),
+		ExpiresAt:              expiresAt,
+		Conditions:             conditions,
+	}
+
+	identity, err := client.IAM().CreateFederatedIdentity(ctx, createReq)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create federated identity: %w", err))
+	}
+	if identity == nil {
+		return diag.FromErr(fmt.Errorf("failed to create federated identity"))
+	}
+	d.SetId(identity.Identity)
+
+	// New federated identities are always created active
+	if !d.Get("enabled").(bool) {
+		if _, err := client.IAM().UpdateFederatedIdentity(ctx, identity.Identity, iam.UpdateFederatedIdentityRequest{
+			Status: iam.FederatedIdentityStatusInactive,
+		}); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to disable federated identity: %w", err))
+		}
+	}
+
+	return resourceFederatedIdentityRead(ctx, d, m)
+}
+
+func resourceFederatedIdentityRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	identity, err := client.IAM().GetFederatedIdentity(ctx, d.Id())
+	if err != nil {
+		if tcclient.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to get federated identity: %w", err))
+	}
+	if identity == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("name", identity.Name)
+	_ = d.Set("description", identity.Description)
+	_ = d.Set("labels", identity.Labels)
+	_ = d.Set("annotations", identity.Annotations)
+	if identity.ServiceAccount != nil {
+		_ = d.Set("service_account_id", identity.ServiceAccount.Identity)
+	}
+	if identity.Provider != nil {
+		_ = d.Set("provider_id", identity.Provider.Identity)
+	}
+	_ = d.Set("subject", identity.ProviderSubject)
+	_ = d.Set("trusted_audiences", toListOfInterfaces(identity.TrustedAudiences))
+	if identity.AudienceMatchMode != "" {
+		_ = d.Set("audience_match_mode", string(identity.AudienceMatchMode))
+	}
+	_ = d.Set("allowed_scopes", flattenAccessCredentialsScopes(identity.AllowedScopes))
+	if len(identity.Conditions) > 0 {
+		conditions, err := json.Marshal(identity.Conditions)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to encode conditions: %w", err))
+		}
+		_ = d.Set("conditions", string(conditions))
+	} else {
+		_ = d.Set("conditions", "")
+	}
+	if identity.ExpiresAt != nil {
+		_ = d.Set("expires_at", identity.ExpiresAt.Format(TimeFormatRFC3339))
+	} else {
+		_ = d.Set("expires_at", "")
+	}
+	// expired and revoked identities keep the configured value, so that they are not re-activated
+	switch identity.Status {
+	case iam.FederatedIdentityStatusActive:
+		_ = d.Set("enabled", true)
+	case iam.FederatedIdentityStatusInactive:
+		_ = d.Set("enabled", false)
+	}
+	_ = d.Set("status", string(identity.Status))
+	_ = d.Set("created_at", identity.CreatedAt.Format(TimeFormatRFC3339))
+	_ = d.Set("object_version", identity.ObjectVersion)
+	if identity.UpdatedAt != nil {
+		_ = d.Set("updated_at", identity.UpdatedAt.Format(TimeFormatRFC3339))
+	}
+	if identity.LastUsedAt != nil {
+		_ = d.Set("last_used_at", identity.LastUsedAt.Format(TimeFormatRFC3339))
+	}
+
+	return nil
+}
+
+func resourceFederatedIdentityUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	expiresAt, err := parseOptionalTime(d.Get("expires_at").(string))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("invalid expires_at format: %w", err))
+	}
+	conditions, err := expandConditions(d.Get("conditions").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updateReq := iam.UpdateFederatedIdentityRequest{
+		Name:              d.Get("name").(string),
+		Description:       d.Get("description").(string),
+		Labels:            convert.ConvertToMap(d.Get("labels")),
+		Annotations:       convert.ConvertToMap(d.Get("annotations")),
+		TrustedAudiences:  convert.ConvertToStringSlice(d.Get("trusted_audiences")),
+		AudienceMatchMode: iam.AudienceMatchMode(d.Get("audience_match_mode").(string)),
+		AllowedScopes:     expandAccessCredentialsScopes(d.Get("allowed_scopes")),
+		ExpiresAt:         expiresAt,
+		Conditions:        conditions,
+	}
+	if d.HasChange("enabled") {
+		updateReq.Status = iam.FederatedIdentityStatusInactive
+		if d.Get("enabled").(bool) {
+			updateReq.Status = iam.FederatedIdentityStatusActive
+		}
+	}
+
+	if _, err := client.IAM().UpdateFederatedIdentity(ctx, d.Id(), updateReq); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update federated identity: %w", err))
+	}
+
+	return resourceFederatedIdentityRead(ctx, d, m)
+}
+
+func resourceFederatedIdentityDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.IAM().DeleteFederatedIdentity(ctx, d.Id()); err != nil {
+		if tcclient.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to delete federated identity: %w", err))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandConditions(value string) (map[string]any, error) {
+	if value == "" {
+		return nil, nil
+	}
+	conditions := map[string]any{}
+	if err := json.Unmarshal([]byte(value), &conditions); err != nil {
+		return nil, fmt.Errorf("invalid conditions: %w", err)
+	}
+	return conditions, nil
+}
+
+func parseOptionalTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
@@ -0,0 +1,265 @@
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	validate "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+
+	iam "github.com/thalassa-cloud/client-go/iam"
+	tcclient "github.com/thalassa-cloud/client-go/pkg/client"
+)
+
+func ResourceFederatedIdentityProvider() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a federated identity provider (an external OIDC issuer such as GitHub Actions or GitLab CI) that is trusted for workload identity token exchange",
+		CreateContext: resourceFederatedIdentityProviderCreate,
+		ReadContext:   resourceFederatedIdentityProviderRead,
+		UpdateContext: resourceFederatedIdentityProviderUpdate,
+		DeleteContext: resourceFederatedIdentityProviderDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"organisation_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Organisation of the federated identity provider. If not provided, the organisation of the (Terraform) provider will be used.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.StringLenBetween(1, 255),
+				Description:  "Name of the federated identity provider",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.StringLenBetween(0, 255),
+				Description:  "Description of the federated identity provider",
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Labels for the federated identity provider",
+			},
+			"annotations": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Annotations for the federated identity provider",
+			},
+			"issuer": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.IsURLWithHTTPS,
+				Description:  "Issuer URL of the OIDC provider, matching the `iss` claim of its tokens (e.g. `https://token.actions.githubusercontent.com`). Must be unique within the organisation.",
+			},
+			"jwks_uri": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.IsURLWithHTTPS,
+				Description:  "JWKS URI that overrides the one discovered from the issuer's OpenID configuration",
+			},
+			"local_jwks": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validate.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				Description:      "JSON encoded JWKS used for offline token verification instead of fetching keys from the issuer. Useful for air-gapped issuers or to pin specific keys.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether tokens from this provider are accepted. Defaults to `true`.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the federated identity provider",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation timestamp of the federated identity provider",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last update timestamp of the federated identity provider",
+			},
+			"object_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Object version of the federated identity provider",
+			},
+		},
+	}
+}
+
+func resourceFederatedIdentityProviderCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createReq := iam.CreateFederatedIdentityProviderRequest{
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		Labels:         convert.ConvertToMap(d.Get("labels")),
+		Annotations:    convert.ConvertToMap(d.Get("annotations")),
+		ProviderIssuer: d.Get("issuer").(string),
+		Status:         federatedIdentityProviderStatus(d.Get("enabled").(bool)),
+	}
+	if jwksURI := d.Get("jwks_uri").(string); jwksURI != "" {
+		createReq.ProviderJwksURI = &jwksURI
+	}
+	if localJWKS := d.Get("local_jwks").(string); localJWKS != "" {
+		jwks, err := expandLocalJWKS(localJWKS)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		createReq.LocalJWKS = jwks
+	}
+
+	federatedProvider, err := client.IAM().CreateFederatedIdentityProvider(ctx, createReq)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create federated identity provider: %w", err))
+	}
+	if federatedProvider == nil {
+		return diag.FromErr(fmt.Errorf("failed to create federated identity provider"))
+	}
+
+	d.SetId(federatedProvider.Identity)
+	return resourceFederatedIdentityProviderRead(ctx, d, m)
+}
+
+func resourceFederatedIdentityProviderRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	federatedProvider, err := client.IAM().GetFederatedIdentityProvider(ctx, d.Id())
+	if err != nil {
+		if tcclient.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to get federated identity provider: %w", err))
+	}
+	if federatedProvider == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("name", federatedProvider.Name)
+	_ = d.Set("description", federatedProvider.Description)
+	_ = d.Set("labels", federatedProvider.Labels)
+	_ = d.Set("annotations", federatedProvider.Annotations)
+	_ = d.Set("issuer", federatedProvider.ProviderIssuer)
+	if federatedProvider.ProviderJwksURI != nil {
+		_ = d.Set("jwks_uri", *federatedProvider.ProviderJwksURI)
+	} else {
+		_ = d.Set("jwks_uri", "")
+	}
+	if federatedProvider.LocalJWKS != nil {
+		localJWKS, err := flattenLocalJWKS(federatedProvider.LocalJWKS)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_ = d.Set("local_jwks", localJWKS)
+	}
+	_ = d.Set("enabled", federatedProvider.Status != iam.FederatedIdentityProviderStatusInactive)
+	_ = d.Set("status", string(federatedProvider.Status))
+	_ = d.Set("created_at", federatedProvider.CreatedAt.Format(TimeFormatRFC3339))
+	_ = d.Set("object_version", federatedProvider.ObjectVersion)
+	if federatedProvider.UpdatedAt != nil {
+		_ = d.Set("updated_at", federatedProvider.UpdatedAt.Format(TimeFormatRFC3339))
+	}
+
+	return nil
+}
+
+func resourceFederatedIdentityProviderUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updateReq := iam.UpdateFederatedIdentityProviderRequest{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Labels:      convert.ConvertToMap(d.Get("labels")),
+		Annotations: convert.ConvertToMap(d.Get("annotations")),
+		Status:      federatedIdentityProviderStatus(d.Get("enabled").(bool)),
+	}
+	if jwksURI := d.Get("jwks_uri").(string); jwksURI != "" {
+		updateReq.ProviderJwksURI = &jwksURI
+	}
+
+	if _, err := client.IAM().UpdateFederatedIdentityProvider(ctx, d.Id(), updateReq); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update federated identity provider: %w", err))
+	}
+
+	return resourceFederatedIdentityProviderRead(ctx, d, m)
+}
+
+func resourceFederatedIdentityProviderDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.IAM().DeleteFederatedIdentityProvider(ctx, d.Id()); err != nil {
+		if tcclient.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to delete federated identity provider: %w", err))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func federatedIdentityProviderStatus(enabled bool) iam.FederatedIdentityProviderStatus {
+	if enabled {
+		return iam.FederatedIdentityProviderStatusActive
+	}
+	return iam.FederatedIdentityProviderStatusInactive
+}
+
+func expandLocalJWKS(value string) (*iam.LocalJWKS, error) {
+	jwks := &iam.LocalJWKS{}
+	if err := json.Unmarshal([]byte(value), jwks); err != nil {
+		return nil, fmt.Errorf("invalid local_jwks: %w", err)
+	}
+	if len(jwks.Keys) == 0 {
+		return nil, fmt.Errorf("invalid local_jwks: no keys defined")
+	}
+	return jwks, nil
+}
+
+func flattenLocalJWKS(jwks *iam.LocalJWKS) (string, error) {
+	b, err := json.Marshal(jwks)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode local_jwks: %w", err)
+	}
+	return string(b), nil
+}
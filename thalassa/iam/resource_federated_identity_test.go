@@ -0,0 +1,93 @@
+package iam
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	iam "github.com/thalassa-cloud/client-go/iam"
+)
+
+func TestResourceFederatedIdentityProvider(t *testing.T) {
+	resource := ResourceFederatedIdentityProvider()
+
+	t.Run("resource schema validation", func(t *testing.T) {
+		schema := resource.Schema
+		assert.True(t, schema["name"].Required)
+		assert.True(t, schema["issuer"].Required)
+		assert.True(t, schema["issuer"].ForceNew)
+		assert.True(t, schema["jwks_uri"].Optional)
+		assert.False(t, schema["jwks_uri"].ForceNew)
+		assert.True(t, schema["local_jwks"].ForceNew)
+		assert.Equal(t, true, schema["enabled"].Default)
+		assert.True(t, schema["status"].Computed)
+		assert.NotNil(t, resource.Importer)
+	})
+
+	t.Run("status from enabled", func(t *testing.T) {
+		assert.Equal(t, iam.FederatedIdentityProviderStatusActive, federatedIdentityProviderStatus(true))
+		assert.Equal(t, iam.FederatedIdentityProviderStatusInactive, federatedIdentityProviderStatus(false))
+	})
+}
+
+func TestResourceFederatedIdentity(t *testing.T) {
+	resource := ResourceFederatedIdentity()
+
+	t.Run("resource schema validation", func(t *testing.T) {
+		schema := resource.Schema
+		assert.True(t, schema["service_account_id"].Required)
+		assert.True(t, schema["service_account_id"].ForceNew)
+		assert.True(t, schema["provider_id"].Required)
+		assert.True(t, schema["provider_id"].ForceNew)
+		assert.True(t, schema["subject"].Required)
+		assert.True(t, schema["subject"].ForceNew)
+		assert.True(t, schema["trusted_audiences"].Required)
+		assert.False(t, schema["trusted_audiences"].ForceNew)
+		assert.Equal(t, "any", schema["audience_match_mode"].Default)
+		assert.True(t, schema["last_used_at"].Computed)
+		assert.NotNil(t, resource.Importer)
+	})
+}
+
+func TestLocalJWKS(t *testing.T) {
+	jwks, err := expandLocalJWKS(`{"keys":[{"kty":"RSA","kid":"key-1","n":"abc","e":"AQAB"}]}`)
+	assert.NoError(t, err)
+	assert.Len(t, jwks.Keys, 1)
+	assert.Equal(t, "key-1", jwks.Keys[0]["kid"])
+
+	encoded, err := flattenLocalJWKS(jwks)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"keys":[{"kty":"RSA","kid":"key-1","n":"abc","e":"AQAB"}]}`, encoded)
+
+	_, err = expandLocalJWKS(`{"keys":[]}`)
+	assert.Error(t, err)
+	_, err = expandLocalJWKS(`not json`)
+	assert.Error(t, err)
+}
+
+func TestExpandConditions(t *testing.T) {
+	conditions, err := expandConditions("")
+	assert.NoError(t, err)
+	assert.Nil(t, conditions)
+
+	conditions, err = expandConditions(`{"ref_protected":true,"environment":"production"}`)
+	assert.NoError(t, err)
+	assert.Equal(t, true, conditions["ref_protected"])
+	assert.Equal(t, "production", conditions["environment"])
+
+	_, err = expandConditions(`[1,2]`)
+	assert.Error(t, err)
+}
+
+func TestAccessCredentialsScopes(t *testing.T) {
+	scopes := expandAccessCredentialsScopes([]any{"api:read", "kubernetes"})
+	assert.Equal(t, []iam.AccessCredentialsScope{"api:read", "kubernetes"}, scopes)
+	assert.Equal(t, []any{"api:read", "kubernetes"}, flattenAccessCredentialsScopes(scopes))
+	assert.Empty(t, expandAccessCredentialsScopes(nil))
+}
+
+func TestSuppressEquivalentRFC3339(t *testing.T) {
+	assert.True(t, suppressEquivalentRFC3339("expires_at", "2026-01-01T10:00:00Z", "2026-01-01T12:00:00+02:00", nil))
+	assert.False(t, suppressEquivalentRFC3339("expires_at", "2026-01-01T10:00:00Z", "2026-01-02T10:00:00Z", nil))
+	assert.False(t, suppressEquivalentRFC3339("expires_at", "", "2026-01-01T10:00:00Z", nil))
+}
@@ -10,6 +10,8 @@ var (
 		"thalassa_iam_role_binding":                      ResourceRoleBinding(),
 		"thalassa_iam_service_account":                   ResourceServiceAccount(),
 		"thalassa_iam_service_account_access_credential": ResourceServiceAccountAccessCredential(),
+		"thalassa_iam_federated_identity_provider":       ResourceFederatedIdentityProvider(),
+		"thalassa_iam_federated_identity":                ResourceFederatedIdentity(),
 	}
 
 	DataSourcesMap = map[string]*schema.Resource{
@@ -0,0 +1,79 @@
+package kms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+var _ action.ActionWithConfigure = &kmsKeyRotateAction{}
+
+// NewKmsKeyRotateAction returns the thalassa_kms_key_rotate action.
+func NewKmsKeyRotateAction() action.Action {
+	return &kmsKeyRotateAction{}
+}
+
+type kmsKeyRotateAction struct {
+	provider.ActionBase
+}
+
+type kmsKeyRotateModel struct {
+	OrganisationID types.String `tfsdk:"organisation_id"`
+	ProjectID      types.String `tfsdk:"project_id"`
+	Region         types.String `tfsdk:"region"`
+	KeyID          types.String `tfsdk:"key_id"`
+}
+
+func (a *kmsKeyRotateAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_key_rotate"
+}
+
+func (a *kmsKeyRotateAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Rotate a KMS key, creating a new key version that is used for all new encryptions",
+		Attributes: map[string]schema.Attribute{
+			"organisation_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Organisation ID. Defaults to the provider organisation.",
+			},
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Project ID. Defaults to the provider project.",
+			},
+			"region": schema.StringAttribute{
+				Required:    true,
+				Description: "Region slug where the key is stored (e.g. nl-01).",
+			},
+			"key_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Identity of the KMS key to rotate.",
+			},
+		},
+	}
+}
+
+func (a *kmsKeyRotateAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data kmsKeyRotateModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := a.Client(data.OrganisationID, data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := client.KMS().RotateKey(ctx, data.Region.ValueString(), data.KeyID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to rotate KMS key", err.Error())
+		return
+	}
+	provider.SendActionProgress(resp, fmt.Sprintf("Rotated KMS key %s to version %d", data.KeyID.ValueString(), key.LatestVersion))
+}
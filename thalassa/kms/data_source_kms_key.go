@@ -28,6 +28,11 @@ func DataSourceKmsKey() *schema.Resource {
 				Optional:    true,
 				Description: "Organisation ID. Defaults to the provider organisation.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Project ID. Defaults to the provider project.",
+			},
 			"region": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -19,6 +19,11 @@ func DataSourceKmsSummary() *schema.Resource {
 				Optional:    true,
 				Description: "Organisation ID. Defaults to the provider organisation.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Project ID. Defaults to the provider project.",
+			},
 			"feature_enabled": {
 				Type:        schema.TypeBool,
 				Computed:    true,
@@ -37,6 +37,12 @@ func ResourceKmsKey() *schema.Resource {
 				ForceNew:    true,
 				Description: "Organisation ID. Defaults to the provider organisation.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Project ID. Defaults to the provider project.",
+			},
 			"region": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -99,11 +105,27 @@ func ResourceKmsKey() *schema.Resource {
 				Description:  "Automatic rotation period in days.",
 			},
 			"import_key_material": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Sensitive:   true,
-				ForceNew:    true,
-				Description: "Base64-encoded key material for BYOK import.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ForceNew:      true,
+				ConflictsWith: []string{"import_key_material_wo"},
+				Description:   "Base64-encoded key material for BYOK import. The key material is stored in the Terraform state; use import_key_material_wo to keep it out of the state.",
+			},
+			"import_key_material_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				ConflictsWith: []string{"import_key_material"},
+				Description:   "Base64-encoded key material for BYOK import, as a write-only argument that is never stored in the Terraform plan or state. Requires Terraform 1.11 or later.",
+			},
+			"import_key_material_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"import_key_material_wo"},
+				Description:  "Version of import_key_material_wo. Changing it replaces the key with one imported from the current import_key_material_wo.",
 			},
 			"hash_function": {
 				Type:        schema.TypeString,
@@ -202,6 +224,13 @@ func resourceKmsKeyCreate(ctx context.Context, d *schema.ResourceData, m any) di
 	if v, ok := d.GetOk("import_key_material"); ok {
 		createReq.ImportKeyMaterial = v.(string)
 	}
+	keyMaterial, diags := convert.WriteOnlyString(d, "import_key_material_wo")
+	if diags.HasError() {
+		return diags
+	}
+	if keyMaterial != "" {
+		createReq.ImportKeyMaterial = keyMaterial
+	}
 	if v, ok := d.GetOk("hash_function"); ok {
 		createReq.HashFunction = v.(string)
 	}
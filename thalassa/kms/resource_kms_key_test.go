@@ -17,6 +17,8 @@ func TestResourceKmsKey(t *testing.T) {
 		assert.True(t, schema["key_type"].Required)
 		assert.Nil(t, schema["identity"])
 		assert.NotNil(t, resource.Importer)
+		assert.True(t, schema["import_key_material_wo"].WriteOnly)
+		assert.True(t, schema["import_key_material_wo_version"].ForceNew)
 	})
 
 	t.Run("CRUD handlers", func(t *testing.T) {
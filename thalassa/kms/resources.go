@@ -1,6 +1,9 @@
 package kms
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
 
 var ResourcesMap = map[string]*schema.Resource{
 	"thalassa_kms_key": ResourceKmsKey(),
@@ -9,3 +12,7 @@ var ResourcesMap = map[string]*schema.Resource{
 var DataSourcesMap = map[string]*schema.Resource{
 	"thalassa_kms_key": DataSourceKmsKey(),
 }
+
+var Actions = []func() action.Action{
+	NewKmsKeyRotateAction,
+}
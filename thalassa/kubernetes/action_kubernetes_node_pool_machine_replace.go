@@ -0,0 +1,137 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/thalassa-cloud/client-go/kubernetes"
+	"github.com/thalassa-cloud/client-go/thalassa"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/waiter"
+)
+
+const (
+	nodePoolMachineReplaceTimeout      = 30 * time.Minute
+	nodePoolMachineReplacePollInterval = 10 * time.Second
+)
+
+var _ action.ActionWithConfigure = &nodePoolMachineReplaceAction{}
+
+// NewNodePoolMachineReplaceAction returns the thalassa_kubernetes_node_pool_machine_replace action.
+func NewNodePoolMachineReplaceAction() action.Action {
+	return &nodePoolMachineReplaceAction{}
+}
+
+type nodePoolMachineReplaceAction struct {
+	provider.ActionBase
+}
+
+type nodePoolMachineReplaceModel struct {
+	OrganisationID    types.String `tfsdk:"organisation_id"`
+	ProjectID         types.String `tfsdk:"project_id"`
+	ClusterID         types.String `tfsdk:"cluster_id"`
+	NodePoolID        types.String `tfsdk:"node_pool_id"`
+	MachineID         types.String `tfsdk:"machine_id"`
+	WaitUntilComplete types.Bool   `tfsdk:"wait_until_complete"`
+}
+
+func (a *nodePoolMachineReplaceAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kubernetes_node_pool_machine_replace"
+}
+
+func (a *nodePoolMachineReplaceAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Replace a machine of a Kubernetes node pool. The machine is deleted without scaling down the node pool, so the node pool creates a new machine in its place.",
+		Attributes: map[string]schema.Attribute{
+			"organisation_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Reference to the Organisation of the Kubernetes Cluster. If not provided, the organisation of the (Terraform) provider will be used.",
+			},
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
+			"cluster_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Kubernetes cluster",
+			},
+			"node_pool_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the node pool",
+			},
+			"machine_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the node pool machine to replace",
+			},
+			"wait_until_complete": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Wait until the machine has been removed from the node pool. Defaults to true.",
+			},
+		},
+	}
+}
+
+func (a *nodePoolMachineReplaceAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data nodePoolMachineReplaceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := a.Client(data.OrganisationID, data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID, nodePoolID, machineID := data.ClusterID.ValueString(), data.NodePoolID.ValueString(), data.MachineID.ValueString()
+	if err := client.Kubernetes().DeleteNodePoolMachine(ctx, clusterID, nodePoolID, machineID, false); err != nil {
+		resp.Diagnostics.AddError("Failed to replace node pool machine", err.Error())
+		return
+	}
+	provider.SendActionProgress(resp, fmt.Sprintf("Requested replacement of node pool machine %s", machineID))
+
+	if !data.WaitUntilComplete.IsNull() && !data.WaitUntilComplete.ValueBool() {
+		return
+	}
+
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, nodePoolMachineReplaceTimeout)
+	defer cancel()
+	if err := waitForNodePoolMachineRemoved(ctxWithTimeout, client, clusterID, nodePoolID, machineID); err != nil {
+		resp.Diagnostics.AddError("Failed to replace node pool machine", err.Error())
+		return
+	}
+	provider.SendActionProgress(resp, fmt.Sprintf("Node pool machine %s has been removed", machineID))
+}
+
+// waitForNodePoolMachineRemoved waits until the machine is no longer listed in the node pool.
+func waitForNodePoolMachineRemoved(ctx context.Context, client thalassa.Client, clusterID, nodePoolID, machineID string) error {
+	_, err := waiter.Waiter[*kubernetes.KubernetesNodePoolMachine]{
+		Description: fmt.Sprintf("node pool machine %q", machineID),
+		Refresh: func(ctx context.Context) (*kubernetes.KubernetesNodePoolMachine, error) {
+			machines, err := client.Kubernetes().ListNodePoolMachines(ctx, clusterID, nodePoolID)
+			if err != nil {
+				return nil, err
+			}
+			for i, machine := range machines {
+				if machine.Identity == machineID {
+					return &machines[i], nil
+				}
+			}
+			return nil, waiter.ErrNotFound
+		},
+		// The machine has no status of its own, it is listed until it has been removed.
+		State:          func(*kubernetes.KubernetesNodePoolMachine) string { return "listed" },
+		Failed:         []string{},
+		TargetNotFound: true,
+		MinInterval:    nodePoolMachineReplacePollInterval,
+		MaxInterval:    time.Minute,
+	}.Wait(ctx)
+	return err
+}
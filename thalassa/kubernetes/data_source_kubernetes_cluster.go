@@ -36,6 +36,11 @@ func DataSourceKubernetesCluster() *schema.Resource {
 				ForceNew:    true,
 				Description: "Organisation of the Kubernetes Cluster",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"region": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -24,6 +24,11 @@ func dataSourceKubernetesClusterSessionToken() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation of the Kubernetes Cluster. If not provided, the organisation of the (Terraform) provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"username": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -36,6 +36,11 @@ func DataSourceKubernetesVersion() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation of the Kubernetes Version. If not provided, the organisation of the (Terraform) provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"kubernetes_version": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -0,0 +1,113 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &kubernetesClusterSessionTokenEphemeralResource{}
+
+// NewKubernetesClusterSessionTokenEphemeralResource returns the thalassa_kubernetes_cluster_session_token ephemeral resource.
+func NewKubernetesClusterSessionTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &kubernetesClusterSessionTokenEphemeralResource{}
+}
+
+type kubernetesClusterSessionTokenEphemeralResource struct {
+	provider.EphemeralResourceBase
+}
+
+type kubernetesClusterSessionTokenModel struct {
+	ClusterID      types.String `tfsdk:"cluster_id"`
+	OrganisationID types.String `tfsdk:"organisation_id"`
+	ProjectID      types.String `tfsdk:"project_id"`
+	Username       types.String `tfsdk:"username"`
+	APIServerURL   types.String `tfsdk:"api_server_url"`
+	CACertificate  types.String `tfsdk:"ca_certificate"`
+	Token          types.String `tfsdk:"token"`
+	Kubeconfig     types.String `tfsdk:"kubeconfig"`
+}
+
+func (r *kubernetesClusterSessionTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kubernetes_cluster_session_token"
+}
+
+func (r *kubernetesClusterSessionTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Get a temporary session token for authenticating with a Kubernetes cluster for the currently authenticated user or system account. The token is never stored in the Terraform plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Kubernetes cluster to get a session token for",
+			},
+			"organisation_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Reference to the Organisation of the Kubernetes Cluster. If not provided, the organisation of the (Terraform) provider will be used.",
+			},
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
+			"username": schema.StringAttribute{
+				Computed:    true,
+				Description: "The username for cluster authentication",
+			},
+			"api_server_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The URL of the Kubernetes API server",
+			},
+			"ca_certificate": schema.StringAttribute{
+				Computed:    true,
+				Description: "The CA certificate of the API server",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The authentication token for the session",
+			},
+			"kubeconfig": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The complete kubeconfig file content for the session",
+			},
+		},
+	}
+}
+
+func (r *kubernetesClusterSessionTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data kubernetesClusterSessionTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.Client(data.OrganisationID, data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID := data.ClusterID.ValueString()
+	sessionToken, err := client.Kubernetes().GetKubernetesClusterKubeconfig(ctx, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get Kubernetes cluster session token", err.Error())
+		return
+	}
+	if sessionToken == nil {
+		resp.Diagnostics.AddError("Failed to get Kubernetes cluster session token", fmt.Sprintf("no session token received for cluster %s", clusterID))
+		return
+	}
+
+	data.Username = types.StringValue(sessionToken.Username)
+	data.APIServerURL = types.StringValue(sessionToken.APIServerURL)
+	data.CACertificate = types.StringValue(sessionToken.CACertificate)
+	data.Token = types.StringValue(sessionToken.Token)
+	data.Kubeconfig = types.StringValue(sessionToken.Kubeconfig)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,138 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	tckubernetes "github.com/thalassa-cloud/client-go/kubernetes"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &kubernetesKubeconfigEphemeralResource{}
+
+// NewKubernetesKubeconfigEphemeralResource returns the thalassa_kubernetes_kubeconfig ephemeral resource.
+func NewKubernetesKubeconfigEphemeralResource() ephemeral.EphemeralResource {
+	return &kubernetesKubeconfigEphemeralResource{}
+}
+
+type kubernetesKubeconfigEphemeralResource struct {
+	provider.EphemeralResourceBase
+}
+
+type kubernetesKubeconfigModel struct {
+	ClusterID       types.String `tfsdk:"cluster_id"`
+	OrganisationID  types.String `tfsdk:"organisation_id"`
+	ProjectID       types.String `tfsdk:"project_id"`
+	SessionLifetime types.String `tfsdk:"session_lifetime"`
+	Kubeconfig      types.String `tfsdk:"kubeconfig"`
+	APIServerURL    types.String `tfsdk:"api_server_url"`
+	CACertificate   types.String `tfsdk:"ca_certificate"`
+	Token           types.String `tfsdk:"token"`
+	ExpiresAt       types.String `tfsdk:"expires_at"`
+}
+
+func (r *kubernetesKubeconfigEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kubernetes_kubeconfig"
+}
+
+func (r *kubernetesKubeconfigEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Get a kubeconfig for a Kubernetes cluster with a session of a chosen lifetime. The kubeconfig is never stored in the Terraform plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Kubernetes cluster to get a kubeconfig for",
+			},
+			"organisation_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Reference to the Organisation of the Kubernetes Cluster. If not provided, the organisation of the (Terraform) provider will be used.",
+			},
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
+			"session_lifetime": schema.StringAttribute{
+				Optional:    true,
+				Description: "Lifetime of the session as a duration (e.g. `30m`, `2h`). Defaults to the platform session lifetime.",
+				Validators:  []validator.String{durationValidator{}},
+			},
+			"kubeconfig": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The complete kubeconfig file content",
+			},
+			"api_server_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The URL of the Kubernetes API server",
+			},
+			"ca_certificate": schema.StringAttribute{
+				Computed:    true,
+				Description: "The CA certificate of the API server",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The authentication token of the session",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the session expires (RFC3339), if session_lifetime is set",
+			},
+		},
+	}
+}
+
+func (r *kubernetesKubeconfigEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data kubernetesKubeconfigModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.Client(data.OrganisationID, data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var params tckubernetes.KubeconfigParams
+	if lifetime := data.SessionLifetime.ValueString(); lifetime != "" {
+		duration, err := time.ParseDuration(lifetime)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("session_lifetime"), "Invalid session lifetime", err.Error())
+			return
+		}
+		params.SessionLifetime = duration
+	}
+
+	requestedAt := time.Now()
+	clusterID := data.ClusterID.ValueString()
+	session, err := client.Kubernetes().GetKubernetesClusterKubeconfigWithParams(ctx, clusterID, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get Kubernetes kubeconfig", err.Error())
+		return
+	}
+	if session == nil {
+		resp.Diagnostics.AddError("Failed to get Kubernetes kubeconfig", fmt.Sprintf("no kubeconfig received for cluster %s", clusterID))
+		return
+	}
+
+	data.Kubeconfig = types.StringValue(session.Kubeconfig)
+	data.APIServerURL = types.StringValue(session.APIServerURL)
+	data.CACertificate = types.StringValue(session.CACertificate)
+	data.Token = types.StringValue(session.Token)
+	data.ExpiresAt = types.StringNull()
+	if params.SessionLifetime > 0 {
+		data.ExpiresAt = types.StringValue(requestedAt.Add(params.SessionLifetime).UTC().Format(time.RFC3339))
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -1,6 +1,12 @@
 package kubernetes
 
-import kubernetes "github.com/thalassa-cloud/client-go/kubernetes"
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	kubernetes "github.com/thalassa-cloud/client-go/kubernetes"
+)
 
 func clusterVersionReferenceMatches(configured string, version kubernetes.KubernetesVersion) bool {
 	return version.Name == configured ||
@@ -14,3 +20,24 @@ func resolvedClusterVersionReference(configured string, version kubernetes.Kuber
 	}
 	return version.Slug
 }
+
+// durationValidator validates that a string is a positive Go duration such as 30m or 2h.
+type durationValidator struct{}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return "value must be a positive duration such as 30m or 2h"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	duration, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || duration <= 0 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid duration", v.Description(ctx))
+	}
+}
@@ -0,0 +1,29 @@
+package kubernetes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+
+	"github.com/thalassa-cloud/client-go/filters"
+	kubernetes "github.com/thalassa-cloud/client-go/kubernetes"
+	"github.com/thalassa-cloud/client-go/thalassa"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+// NewKubernetesClusterListResource returns the thalassa_kubernetes_cluster list resource.
+func NewKubernetesClusterListResource() list.ListResource {
+	return &provider.SDKListResource{
+		TypeName:    "kubernetes_cluster",
+		Description: "Kubernetes clusters",
+		Resource:    resourceKubernetesCluster,
+		Regional:    true,
+		ListObjects: func(ctx context.Context, client thalassa.Client, requestFilters []filters.Filter) ([]provider.ListedObject, error) {
+			clusters, err := client.Kubernetes().ListKubernetesClusters(ctx, &kubernetes.ListKubernetesClustersRequest{Filters: requestFilters})
+			return provider.ListedObjects(clusters, func(cluster kubernetes.KubernetesCluster) provider.ListedObject {
+				return provider.ListedObject{ID: cluster.Identity, Name: cluster.Name}
+			}), err
+		},
+	}
+}
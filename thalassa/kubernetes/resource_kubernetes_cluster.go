@@ -3,7 +3,6 @@ package kubernetes
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -17,7 +16,7 @@ import (
 )
 
 func resourceKubernetesCluster() *schema.Resource {
-	return &schema.Resource{
+	return provider.WithIDIdentity(&schema.Resource{
 		Description: "Manages a Kubernetes cluster on the Thalassa cloud platform. " +
 			"Supports managed clusters and hosted control plane clusters with configurable networking, " +
 			"security policies, auto-upgrade, CNI plugins, network CIDRs, pod security standards, " +
@@ -26,6 +25,10 @@ func resourceKubernetesCluster() *schema.Resource {
 		ReadContext:   resourceKubernetesClusterRead,
 		UpdateContext: resourceKubernetesClusterUpdate,
 		DeleteContext: resourceKubernetesClusterDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -37,6 +40,12 @@ func resourceKubernetesCluster() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation of the Kubernetes Cluster. If not provided, the organisation of the (Terraform) provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"region": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -318,7 +327,7 @@ func resourceKubernetesCluster() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-	}
+	})
 }
 
 func resourceKubernetesClusterCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -431,30 +440,15 @@ func resourceKubernetesClusterCreate(ctx context.Context, d *schema.ResourceData
 		_ = d.Set("status", kubernetesCluster.Status)
 	}
 
-	ctxWithTimeout, cancel := context.WithTimeout(ctx, 20*time.Minute)
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
 	defer cancel()
 
-	// wait until the cluster is ready
-	for {
-		select {
-		case <-ctxWithTimeout.Done():
-			return diag.FromErr(fmt.Errorf("timeout waiting for cluster to be ready"))
-		default:
-		}
-		kubernetesCluster, err := client.Kubernetes().GetKubernetesCluster(ctxWithTimeout, kubernetesCluster.Identity)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		if strings.EqualFold(kubernetesCluster.Status, "error") {
-			return diag.FromErr(fmt.Errorf("cluster is in error state: %s", kubernetesCluster.StatusMessage))
-		}
-		if strings.EqualFold(kubernetesCluster.Status, "ready") {
-			_ = d.Set("kubernetes_api_server_endpoint", kubernetesCluster.APIServerURL)
-			_ = d.Set("kubernetes_api_server_ca_certificate", kubernetesCluster.APIServerCA)
-			break
-		}
-		time.Sleep(1 * time.Second)
+	kubernetesCluster, err = waitForReadyKubernetesCluster(ctxWithTimeout, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	_ = d.Set("kubernetes_api_server_endpoint", kubernetesCluster.APIServerURL)
+	_ = d.Set("kubernetes_api_server_ca_certificate", kubernetesCluster.APIServerCA)
 
 	return resourceKubernetesClusterRead(ctx, d, m)
 }
@@ -757,28 +751,10 @@ func resourceKubernetesClusterDelete(ctx context.Context, d *schema.ResourceData
 	}
 
 	// wait until the cluster is deleted
-	ctxWithTimeout, cancel := context.WithTimeout(ctx, 20*time.Minute)
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
 	defer cancel()
-	for {
-		select {
-		case <-ctxWithTimeout.Done():
-			return diag.FromErr(fmt.Errorf("timeout waiting for cluster to be deleted"))
-		default:
-		}
-		kubernetesCluster, err := client.Kubernetes().GetKubernetesCluster(ctxWithTimeout, identity)
-		if err != nil {
-			if tcclient.IsNotFound(err) {
-				break
-			}
-			return diag.FromErr(err)
-		}
-		if kubernetesCluster == nil {
-			break
-		}
-		if strings.EqualFold(kubernetesCluster.Status, "deleted") {
-			break
-		}
-		time.Sleep(1 * time.Second)
+	if err := waitForDeletedKubernetesCluster(ctxWithTimeout, client, identity); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -7,10 +7,12 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	validate "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	kubernetes "github.com/thalassa-cloud/client-go/kubernetes"
 	tcclient "github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/thalassa"
 	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
 	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
 )
@@ -24,7 +26,7 @@ func resourceKubernetesNodePool() *schema.Resource {
 		ReadContext:   resourceKubernetesNodePoolRead,
 		UpdateContext: resourceKubernetesNodePoolUpdate,
 		DeleteContext: resourceKubernetesNodePoolDelete,
-		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m any) error {
+		CustomizeDiff: customdiff.All(func(ctx context.Context, d *schema.ResourceDiff, m any) error {
 			// If autoscaling is enabled, replicas must be unset by the user
 			if d.Get("enable_autoscaling").(bool) {
 				if _, ok := d.GetOk("replicas"); ok {
@@ -40,6 +42,11 @@ func resourceKubernetesNodePool() *schema.Resource {
 				}
 			}
 			return nil
+		}, provider.QuotaPreflight("thalassa_kubernetes_node_pool", kubernetesNodePoolQuotaDemand)),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -52,6 +59,12 @@ func resourceKubernetesNodePool() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation of the Kubernetes Node Pool. If not provided, the organisation of the (Terraform) provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -253,6 +266,15 @@ func resourceKubernetesNodePool() *schema.Resource {
 	}
 }
 
+// kubernetesNodePoolQuotaDemand counts the initial nodes of the pool: replicas, or min_replicas when autoscaling.
+func kubernetesNodePoolQuotaDemand(ctx context.Context, d *schema.ResourceDiff, client thalassa.Client) (provider.QuotaDemand, error) {
+	nodes := d.Get("replicas").(int)
+	if d.Get("enable_autoscaling").(bool) {
+		nodes = d.Get("min_replicas").(int)
+	}
+	return provider.MachineQuotaDemand(ctx, client, d.Get("machine_type").(string), int64(nodes))
+}
+
 func resourceKubernetesNodePoolCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	client, err := provider.GetClient(provider.GetProvider(m), d)
 	if err != nil {
@@ -359,15 +381,10 @@ func resourceKubernetesNodePoolCreate(ctx context.Context, d *schema.ResourceDat
 		_ = d.Set("slug", kubernetesNodePool.Slug)
 		_ = d.Set("status", kubernetesNodePool.Status)
 
-		for {
-			kubernetesNodePool, err := client.Kubernetes().GetKubernetesNodePool(ctx, kubernetesClusterIdentity, kubernetesNodePool.Identity)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			if kubernetesNodePool.Status == kubernetes.KubernetesNodePoolStatusReady {
-				break
-			}
-			time.Sleep(1 * time.Second)
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+		defer cancel()
+		if _, err := waitForReadyKubernetesNodePool(ctxWithTimeout, client, kubernetesClusterIdentity, kubernetesNodePool.Identity); err != nil {
+			return diag.FromErr(err)
 		}
 		return nil
 	}
@@ -556,15 +573,10 @@ func resourceKubernetesNodePoolUpdate(ctx context.Context, d *schema.ResourceDat
 		_ = d.Set("slug", kubernetesNodePool.Slug)
 		_ = d.Set("status", kubernetesNodePool.Status)
 
-		for {
-			kubernetesNodePool, err := client.Kubernetes().GetKubernetesNodePool(ctx, kubernetesClusterIdentity, nodePoolIdentity)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			if kubernetesNodePool.Status == kubernetes.KubernetesNodePoolStatusReady {
-				break
-			}
-			time.Sleep(1 * time.Second)
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+		defer cancel()
+		if _, err := waitForReadyKubernetesNodePool(ctxWithTimeout, client, kubernetesClusterIdentity, nodePoolIdentity); err != nil {
+			return diag.FromErr(err)
 		}
 		if _, ok := d.GetOk("replicas"); ok {
 			_ = d.Set("replicas", kubernetesNodePool.Replicas)
@@ -605,18 +617,10 @@ func resourceKubernetesNodePoolDelete(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
-	for {
-		kubernetesNodePool, err := client.Kubernetes().GetKubernetesNodePool(ctx, kubernetesClusterIdentity, nodePoolIdentity)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		if kubernetesNodePool == nil {
-			break
-		}
-		if kubernetesNodePool.Status == kubernetes.KubernetesNodePoolStatusDeleted {
-			break
-		}
-		time.Sleep(1 * time.Second)
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+	if err := waitForDeletedKubernetesNodePool(ctxWithTimeout, client, kubernetesClusterIdentity, nodePoolIdentity); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -1,6 +1,11 @@
 package kubernetes
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
 
 var (
 	ResourcesMap = map[string]*schema.Resource{
@@ -16,4 +21,17 @@ var (
 		"thalassa_kubernetes_cluster_session_token": dataSourceKubernetesClusterSessionToken(),
 		"thalassa_kubernetes_cluster_role":          dataSourceKubernetesClusterRole(),
 	}
+
+	EphemeralResources = []func() ephemeral.EphemeralResource{
+		NewKubernetesClusterSessionTokenEphemeralResource,
+		NewKubernetesKubeconfigEphemeralResource,
+	}
+
+	Actions = []func() action.Action{
+		NewNodePoolMachineReplaceAction,
+	}
+
+	ListResources = []func() list.ListResource{
+		NewKubernetesClusterListResource,
+	}
 )
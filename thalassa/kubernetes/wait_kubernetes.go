@@ -0,0 +1,63 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thalassa-cloud/client-go/kubernetes"
+	"github.com/thalassa-cloud/client-go/thalassa"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/waiter"
+)
+
+func kubernetesClusterWaiter(client thalassa.Client, identity string) waiter.Waiter[*kubernetes.KubernetesCluster] {
+	return waiter.Waiter[*kubernetes.KubernetesCluster]{
+		Description: fmt.Sprintf("kubernetes cluster %q", identity),
+		Refresh: func(ctx context.Context) (*kubernetes.KubernetesCluster, error) {
+			return client.Kubernetes().GetKubernetesCluster(ctx, identity)
+		},
+		State:   func(cluster *kubernetes.KubernetesCluster) string { return cluster.Status },
+		Message: func(cluster *kubernetes.KubernetesCluster) string { return cluster.StatusMessage },
+	}
+}
+
+func waitForReadyKubernetesCluster(ctx context.Context, client thalassa.Client, identity string) (*kubernetes.KubernetesCluster, error) {
+	w := kubernetesClusterWaiter(client, identity)
+	w.Target = []string{"ready"}
+	return w.Wait(ctx)
+}
+
+func waitForDeletedKubernetesCluster(ctx context.Context, client thalassa.Client, identity string) error {
+	w := kubernetesClusterWaiter(client, identity)
+	w.Target = []string{"deleted"}
+	w.Failed = []string{}
+	w.TargetNotFound = true
+	_, err := w.Wait(ctx)
+	return err
+}
+
+func kubernetesNodePoolWaiter(client thalassa.Client, clusterIdentity, identity string) waiter.Waiter[*kubernetes.KubernetesNodePool] {
+	return waiter.Waiter[*kubernetes.KubernetesNodePool]{
+		Description: fmt.Sprintf("kubernetes node pool %q", identity),
+		Refresh: func(ctx context.Context) (*kubernetes.KubernetesNodePool, error) {
+			return client.Kubernetes().GetKubernetesNodePool(ctx, clusterIdentity, identity)
+		},
+		State: func(nodePool *kubernetes.KubernetesNodePool) string { return string(nodePool.Status) },
+	}
+}
+
+func waitForReadyKubernetesNodePool(ctx context.Context, client thalassa.Client, clusterIdentity, identity string) (*kubernetes.KubernetesNodePool, error) {
+	w := kubernetesNodePoolWaiter(client, clusterIdentity, identity)
+	w.Target = []string{string(kubernetes.KubernetesNodePoolStatusReady)}
+	w.Failed = []string{string(kubernetes.KubernetesNodePoolStatusFailed)}
+	return w.Wait(ctx)
+}
+
+func waitForDeletedKubernetesNodePool(ctx context.Context, client thalassa.Client, clusterIdentity, identity string) error {
+	w := kubernetesNodePoolWaiter(client, clusterIdentity, identity)
+	w.Target = []string{string(kubernetes.KubernetesNodePoolStatusDeleted)}
+	w.Failed = []string{}
+	w.TargetNotFound = true
+	_, err := w.Wait(ctx)
+	return err
+}
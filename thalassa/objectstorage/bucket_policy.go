@@ -4,13 +4,22 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/thalassa-cloud/client-go/objectstorage"
 )
 
-var thalassaPrincipalARNPattern = regexp.MustCompile(`^arn:thalassa:iam:::(?:serviceaccount|user)/[^:]+:(?:[^:]+|\*)$`)
+var thalassaPrincipalARNPattern = regexp.MustCompile(`^arn:thalassa:iam:::(serviceaccount|user)/([^:]+):([^:]+|\*)$`)
+
+// bucketPolicyVersion is the policy language version of generated bucket policies.
+const bucketPolicyVersion = "2012-10-17"
+
+var (
+	principalKinds      = []string{"serviceaccount", "user"}
+	bucketPolicyEffects = []string{"Allow", "Deny"}
+)
 
 func parseBucketPolicyJSON(raw string) (*objectstorage.PolicyDocument, error) {
 	if raw == "" {
@@ -90,6 +99,88 @@ func validateThalassaPrincipalARN(arn string) error {
 	)
 }
 
+// principalARN builds a Principal.Thalassa ARN for a service account or user. Use "*" as id to match all
+// service accounts or users of the organisation.
+func principalARN(kind, organisationID, id string) (string, error) {
+	if !slices.Contains(principalKinds, kind) {
+		return "", fmt.Errorf("invalid principal kind %q: expected one of %s", kind, strings.Join(principalKinds, ", "))
+	}
+	if organisationID == "" || strings.ContainsAny(organisationID, ":") {
+		return "", fmt.Errorf("invalid organisation ID %q", organisationID)
+	}
+	if id == "" || strings.ContainsAny(id, ":") {
+		return "", fmt.Errorf("invalid %s ID %q", kind, id)
+	}
+
+	arn := fmt.Sprintf("arn:thalassa:iam:::%s/%s:%s", kind, organisationID, id)
+	if err := validateThalassaPrincipalARN(arn); err != nil {
+		return "", err
+	}
+	return arn, nil
+}
+
+// parsePrincipalARN splits a service account or user Principal.Thalassa ARN into its kind, organisation and id.
+func parsePrincipalARN(arn string) (kind, organisationID, id string, err error) {
+	if err := validateThalassaPrincipalARN(arn); err != nil {
+		return "", "", "", err
+	}
+	matches := thalassaPrincipalARNPattern.FindStringSubmatch(strings.TrimSpace(arn))
+	if matches == nil {
+		return "", "", "", fmt.Errorf("principal ARN %q matches all principals and has no kind or organisation", arn)
+	}
+	return matches[1], matches[2], matches[3], nil
+}
+
+// bucketPolicyStatement is a statement of a bucket policy built by the bucket_policy_document function.
+type bucketPolicyStatement struct {
+	Effect     string   `tfsdk:"effect"`
+	Principals []string `tfsdk:"principals"`
+	Actions    []string `tfsdk:"actions"`
+	Resources  []string `tfsdk:"resources"`
+}
+
+// bucketPolicyDocumentJSON builds a bucket policy document and validates it the same way the bucket resource does.
+func bucketPolicyDocumentJSON(statements []bucketPolicyStatement) (string, error) {
+	if len(statements) == 0 {
+		return "", fmt.Errorf("a bucket policy requires at least one statement")
+	}
+
+	doc := objectstorage.PolicyDocument{
+		Version:   bucketPolicyVersion,
+		Statement: make([]objectstorage.Statement, 0, len(statements)),
+	}
+	for i, statement := range statements {
+		if !slices.Contains(bucketPolicyEffects, statement.Effect) {
+			return "", fmt.Errorf("policy statement %d: invalid effect %q: expected one of %s", i, statement.Effect, strings.Join(bucketPolicyEffects, ", "))
+		}
+		if len(statement.Principals) == 0 {
+			return "", fmt.Errorf("policy statement %d: at least one principal is required", i)
+		}
+		if len(statement.Actions) == 0 {
+			return "", fmt.Errorf("policy statement %d: at least one action is required", i)
+		}
+		if len(statement.Resources) == 0 {
+			return "", fmt.Errorf("policy statement %d: at least one resource is required", i)
+		}
+		doc.Statement = append(doc.Statement, objectstorage.Statement{
+			Effect:    statement.Effect,
+			Principal: objectstorage.Principal{Thalassa: statement.Principals},
+			Action:    statement.Actions,
+			Resource:  statement.Resources,
+		})
+	}
+
+	if err := validateBucketPolicyDocument(doc); err != nil {
+		return "", err
+	}
+
+	encoded, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
+
 func enrichBucketError(err error, action string) error {
 	if err == nil {
 		return nil
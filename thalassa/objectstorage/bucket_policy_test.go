@@ -147,3 +147,77 @@ func TestEnrichBucketError(t *testing.T) {
 	assert.Contains(t, err.Error(), "user/<organisation-id>:<user-id>")
 	assert.Contains(t, err.Error(), "user/<organisation-id>/*")
 }
+
+func TestPrincipalARN(t *testing.T) {
+	arn, err := principalARN("serviceaccount", "o-org123", "sa-account456")
+	assert.NoError(t, err)
+	assert.Equal(t, "arn:thalassa:iam:::serviceaccount/o-org123:sa-account456", arn)
+
+	arn, err = principalARN("user", "o-org123", "*")
+	assert.NoError(t, err)
+	assert.Equal(t, "arn:thalassa:iam:::user/o-org123:*", arn)
+
+	_, err = principalARN("organisation", "o-org123", "sa-account456")
+	assert.Error(t, err)
+
+	_, err = principalARN("serviceaccount", "o-org123:x", "sa-account456")
+	assert.Error(t, err)
+
+	_, err = principalARN("serviceaccount", "o-org123", "")
+	assert.Error(t, err)
+}
+
+func TestParsePrincipalARN(t *testing.T) {
+	kind, organisationID, id, err := parsePrincipalARN("arn:thalassa:iam:::serviceaccount/o-org123:sa-account456")
+	assert.NoError(t, err)
+	assert.Equal(t, "serviceaccount", kind)
+	assert.Equal(t, "o-org123", organisationID)
+	assert.Equal(t, "sa-account456", id)
+
+	_, _, _, err = parsePrincipalARN("*")
+	assert.Error(t, err)
+
+	_, _, _, err = parsePrincipalARN("arn:thalassa:iam:::organisation/o-org123")
+	assert.Error(t, err)
+}
+
+func TestBucketPolicyDocumentJSON(t *testing.T) {
+	policy, err := bucketPolicyDocumentJSON([]bucketPolicyStatement{{
+		Effect:     "Allow",
+		Principals: []string{"arn:thalassa:iam:::serviceaccount/o-org123:sa-account456"},
+		Actions:    []string{"s3:GetObject"},
+		Resources:  []string{"arn:thalassa:s3:::example/*"},
+	}})
+	assert.NoError(t, err)
+	assert.True(t, equivalentPolicyJSON(`{
+		"Version": "2012-10-17",
+		"Statement": [{
+			"Effect": "Allow",
+			"Principal": {"Thalassa": ["arn:thalassa:iam:::serviceaccount/o-org123:sa-account456"]},
+			"Action": ["s3:GetObject"],
+			"Resource": ["arn:thalassa:s3:::example/*"]
+		}]
+	}`, policy), policy)
+
+	_, err = parseBucketPolicyJSON(policy)
+	assert.NoError(t, err)
+
+	_, err = bucketPolicyDocumentJSON(nil)
+	assert.Error(t, err)
+
+	_, err = bucketPolicyDocumentJSON([]bucketPolicyStatement{{
+		Effect:     "Permit",
+		Principals: []string{"*"},
+		Actions:    []string{"s3:GetObject"},
+		Resources:  []string{"arn:thalassa:s3:::example/*"},
+	}})
+	assert.Error(t, err)
+
+	_, err = bucketPolicyDocumentJSON([]bucketPolicyStatement{{
+		Effect:     "Allow",
+		Principals: []string{"arn:thalassa:iam:::organisation/o-org123"},
+		Actions:    []string{"s3:GetObject"},
+		Resources:  []string{"arn:thalassa:s3:::example/*"},
+	}})
+	assert.ErrorContains(t, err, "invalid Principal.Thalassa ARN")
+}
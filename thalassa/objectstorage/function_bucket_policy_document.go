@@ -0,0 +1,59 @@
+package objectstorage
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &bucketPolicyDocumentFunction{}
+
+// NewBucketPolicyDocumentFunction returns the bucket_policy_document provider function.
+func NewBucketPolicyDocumentFunction() function.Function {
+	return &bucketPolicyDocumentFunction{}
+}
+
+type bucketPolicyDocumentFunction struct{}
+
+func (f *bucketPolicyDocumentFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "bucket_policy_document"
+}
+
+func (f *bucketPolicyDocumentFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Build a bucket policy document",
+		Description: "Builds the JSON policy document of an object storage bucket from a list of statements. The principals are validated the same way as the policy of the thalassa_objectstorage_bucket resource.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "statements",
+				Description: "Statements of the policy. Each statement is an object with effect (Allow or Deny), principals (principal ARNs or `*`), actions and resources.",
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"effect":     types.StringType,
+						"principals": types.ListType{ElemType: types.StringType},
+						"actions":    types.ListType{ElemType: types.StringType},
+						"resources":  types.ListType{ElemType: types.StringType},
+					},
+				},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *bucketPolicyDocumentFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var statements []bucketPolicyStatement
+	resp.Error = req.Arguments.Get(ctx, &statements)
+	if resp.Error != nil {
+		return
+	}
+
+	policy, err := bucketPolicyDocumentJSON(statements)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, policy)
+}
@@ -0,0 +1,69 @@
+package objectstorage
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &parsePrincipalARNFunction{}
+
+var principalARNAttributeTypes = map[string]attr.Type{
+	"kind":            types.StringType,
+	"organisation_id": types.StringType,
+	"id":              types.StringType,
+}
+
+// NewParsePrincipalARNFunction returns the parse_principal_arn provider function.
+func NewParsePrincipalARNFunction() function.Function {
+	return &parsePrincipalARNFunction{}
+}
+
+type parsePrincipalARNFunction struct{}
+
+func (f *parsePrincipalARNFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_principal_arn"
+}
+
+func (f *parsePrincipalARNFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parse a Thalassa principal ARN",
+		Description: "Parses the Principal.Thalassa ARN of a service account or user into an object with the kind, organisation_id and id attributes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "arn",
+				Description: "Principal ARN to parse, e.g. arn:thalassa:iam:::serviceaccount/<organisation-id>:<service-account-id>.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: principalARNAttributeTypes,
+		},
+	}
+}
+
+func (f *parsePrincipalARNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arn string
+	resp.Error = req.Arguments.Get(ctx, &arn)
+	if resp.Error != nil {
+		return
+	}
+
+	kind, organisationID, id, err := parsePrincipalARN(arn)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, diags := types.ObjectValue(principalARNAttributeTypes, map[string]attr.Value{
+		"kind":            types.StringValue(kind),
+		"organisation_id": types.StringValue(organisationID),
+		"id":              types.StringValue(id),
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, result)
+}
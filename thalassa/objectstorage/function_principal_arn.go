@@ -0,0 +1,57 @@
+package objectstorage
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &principalARNFunction{}
+
+// NewPrincipalARNFunction returns the principal_arn provider function.
+func NewPrincipalARNFunction() function.Function {
+	return &principalARNFunction{}
+}
+
+type principalARNFunction struct{}
+
+func (f *principalARNFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "principal_arn"
+}
+
+func (f *principalARNFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Build a Thalassa principal ARN",
+		Description: "Builds the Principal.Thalassa ARN of a service account or user, for use in bucket policies. Use `*` as id to match all service accounts or users of the organisation.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "kind",
+				Description: "Kind of principal: serviceaccount or user.",
+			},
+			function.StringParameter{
+				Name:        "organisation_id",
+				Description: "Identity of the organisation of the principal.",
+			},
+			function.StringParameter{
+				Name:        "id",
+				Description: "Identity of the service account or user, or `*` for all of them.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *principalARNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var kind, organisationID, id string
+	resp.Error = req.Arguments.Get(ctx, &kind, &organisationID, &id)
+	if resp.Error != nil {
+		return
+	}
+
+	arn, err := principalARN(kind, organisationID, id)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, arn)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -12,7 +11,6 @@ import (
 	validate "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/thalassa-cloud/client-go/objectstorage"
-	tcclient "github.com/thalassa-cloud/client-go/pkg/client"
 
 	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
 	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
@@ -25,6 +23,10 @@ func resourceBucket() *schema.Resource {
 		ReadContext:   resourceBucketRead,
 		UpdateContext: resourceBucketUpdate,
 		DeleteContext: resourceBucketDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -36,6 +38,12 @@ func resourceBucket() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation of the bucket. If not provided, the organisation of the (Terraform) provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -85,6 +93,7 @@ func resourceBucket() *schema.Resource {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				Default:      5,
+				Deprecated:   provider.DeprecatedTimeoutMessage("create"),
 				Description:  "The timeout in minutes to wait for the bucket to be ready. Only used if wait_for_ready is true",
 				ValidateFunc: validate.IntAtLeast(1),
 			},
@@ -98,6 +107,7 @@ func resourceBucket() *schema.Resource {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				Default:      5,
+				Deprecated:   provider.DeprecatedTimeoutMessage("delete"),
 				Description:  "The timeout in minutes to wait for the bucket to be deleted. Only used if wait_for_deleted is true",
 				ValidateFunc: validate.IntAtLeast(1),
 			},
@@ -155,22 +165,10 @@ func resourceBucketCreate(ctx context.Context, d *schema.ResourceData, m any) di
 
 	if v, ok := d.GetOk("wait_for_ready"); ok && v.(bool) {
 		// wait until the bucket is ready
-		ctxWithTimeout, cancel := context.WithTimeout(ctx, time.Duration(d.Get("wait_for_ready_timeout").(int))*time.Minute)
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, provider.Timeout(d, schema.TimeoutCreate, "wait_for_ready_timeout", 5))
 		defer cancel()
-		for {
-			select {
-			case <-ctxWithTimeout.Done():
-				return diag.FromErr(fmt.Errorf("timeout waiting for bucket to be ready"))
-			default:
-			}
-			bucket, err := client.ObjectStorage().GetBucket(ctxWithTimeout, bucket.Identity)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("error getting bucket: %w", err))
-			}
-			if strings.EqualFold(bucket.Status, "ready") {
-				break
-			}
-			time.Sleep(1 * time.Second)
+		if err := waitForReadyBucket(ctxWithTimeout, client, bucket.Identity); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -285,25 +283,10 @@ func resourceBucketDelete(ctx context.Context, d *schema.ResourceData, m any) di
 
 	// wait until the bucket is deleted
 	if v, ok := d.GetOk("wait_for_deleted"); ok && v.(bool) {
-		ctxWithTimeout, cancel := context.WithTimeout(ctx, time.Duration(d.Get("wait_for_deleted_timeout").(int))*time.Minute)
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, provider.Timeout(d, schema.TimeoutDelete, "wait_for_deleted_timeout", 5))
 		defer cancel()
-		for {
-			select {
-			case <-ctxWithTimeout.Done():
-				return diag.FromErr(fmt.Errorf("timeout waiting for bucket to be deleted"))
-			default:
-			}
-			bucket, err := client.ObjectStorage().GetBucket(ctxWithTimeout, name)
-			if err != nil {
-				if tcclient.IsNotFound(err) {
-					break
-				}
-				return diag.FromErr(fmt.Errorf("error getting bucket: %w", err))
-			}
-			if bucket == nil {
-				break
-			}
-			time.Sleep(1 * time.Second)
+		if err := waitForDeletedBucket(ctxWithTimeout, client, name); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -3,7 +3,6 @@ package objectstorage
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -35,6 +34,12 @@ func resourceBucketLifecycle() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"bucket_name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -80,7 +85,7 @@ func resourceBucketLifecycleApply(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(fmt.Errorf("setting bucket lifecycle: %w", err))
 	}
 
-	readLifecycle, readErr := readBucketLifecycle(ctx, client, bucketName)
+	readLifecycle, readErr := waitForBucketLifecycleRules(ctx, client, bucketName)
 	if readErr == nil && readLifecycle != nil && len(readLifecycle.Rules) > 0 {
 		lifecycle = readLifecycle
 	}
@@ -107,36 +112,19 @@ func setBucketLifecycleState(d *schema.ResourceData, bucketName string, lifecycl
 	_ = d.Set("rule", flatRules)
 }
 
+// readBucketLifecycle returns the lifecycle rules of the bucket, as reported by the lifecycle endpoint or, when that
+// reports none, by the bucket itself.
 func readBucketLifecycle(ctx context.Context, client thalassa.Client, bucketName string) (*objectstorage.BucketLifecycle, error) {
-	const attempts = 10
-	const delay = 2 * time.Second
-
-	var last *objectstorage.BucketLifecycle
-	for i := 0; i < attempts; i++ {
-		lifecycle, err := fetchBucketLifecycle(ctx, client, bucketName)
-		if err != nil {
-			return nil, err
-		}
-		last = lifecycle
-
-		if lifecycle != nil && len(lifecycle.Rules) > 0 {
-			return lifecycle, nil
-		}
-
+	lifecycle, err := fetchBucketLifecycle(ctx, client, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	if len(lifecycle.Rules) == 0 {
 		if lifecycleFromBucket, err := bucketLifecycleFromGetBucket(ctx, client, bucketName); err == nil && len(lifecycleFromBucket.Rules) > 0 {
 			return lifecycleFromBucket, nil
 		}
-
-		if i < attempts-1 {
-			select {
-			case <-ctx.Done():
-				return last, ctx.Err()
-			case <-time.After(delay):
-			}
-		}
 	}
-
-	return last, nil
+	return lifecycle, nil
 }
 
 func bucketLifecycleFromGetBucket(ctx context.Context, client thalassa.Client, bucketName string) (*objectstorage.BucketLifecycle, error) {
@@ -161,7 +149,7 @@ func resourceBucketLifecycleRead(ctx context.Context, d *schema.ResourceData, m
 		bucketName = d.Get("bucket_name").(string)
 	}
 
-	lifecycle, err := readBucketLifecycle(ctx, client, bucketName)
+	lifecycle, err := waitForBucketLifecycleRules(ctx, client, bucketName)
 	if err != nil {
 		if tcclient.IsNotFound(err) {
 			d.SetId("")
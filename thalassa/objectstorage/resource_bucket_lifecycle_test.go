@@ -1,12 +1,18 @@
 package objectstorage
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/thalassa-cloud/client-go/objectstorage"
+	tcclient "github.com/thalassa-cloud/client-go/pkg/client"
+	"github.com/thalassa-cloud/client-go/thalassa"
 )
 
 func TestResourceBucketLifecycle(t *testing.T) {
@@ -81,3 +87,36 @@ func TestLifecycleHasNoncurrentRules(t *testing.T) {
 		{ID: "a", NoncurrentVersionExpiration: &objectstorage.BucketLifecycleRuleNoncurrentVersionExpiration{}},
 	}))
 }
+
+func TestWaitForBucketLifecycleRules(t *testing.T) {
+	t.Parallel()
+
+	var lifecycleCalls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/object-storage/buckets/logs/lifecycle":
+			// the rules are reported from the second poll on
+			if lifecycleCalls.Add(1) == 1 {
+				_, _ = w.Write([]byte(`{"rules":[]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"rules":[{"id":"expire-logs","status":"Enabled","expiration":{"days":30}}]}`))
+		case "/v1/object-storage/buckets/logs":
+			_, _ = w.Write([]byte(`{"name":"logs"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := thalassa.NewClient(tcclient.WithBaseURL(server.URL), tcclient.WithAuthNone(), tcclient.WithOrganisation("org-test"))
+	assert.NoError(t, err)
+
+	lifecycle, err := waitForBucketLifecycleRules(context.Background(), client, "logs")
+	assert.NoError(t, err)
+	if assert.Len(t, lifecycle.Rules, 1) {
+		assert.Equal(t, "expire-logs", lifecycle.Rules[0].ID)
+	}
+	assert.Equal(t, int32(2), lifecycleCalls.Load())
+}
@@ -1,6 +1,9 @@
 package objectstorage
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
 
 var ResourcesMap = map[string]*schema.Resource{
 	"thalassa_objectstorage_bucket":           resourceBucket(),
@@ -10,3 +13,9 @@ var ResourcesMap = map[string]*schema.Resource{
 var DataSourcesMap = map[string]*schema.Resource{
 	"thalassa_objectstorage_bucket": DataSourceBucket(),
 }
+
+var Functions = []func() function.Function{
+	NewPrincipalARNFunction,
+	NewParsePrincipalARNFunction,
+	NewBucketPolicyDocumentFunction,
+}
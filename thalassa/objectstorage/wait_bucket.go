@@ -0,0 +1,80 @@
+package objectstorage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thalassa-cloud/client-go/objectstorage"
+	"github.com/thalassa-cloud/client-go/thalassa"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/waiter"
+)
+
+func bucketWaiter(client thalassa.Client, bucketName string) waiter.Waiter[*objectstorage.ObjectStorageBucket] {
+	return waiter.Waiter[*objectstorage.ObjectStorageBucket]{
+		Description: fmt.Sprintf("bucket %q", bucketName),
+		Refresh: func(ctx context.Context) (*objectstorage.ObjectStorageBucket, error) {
+			return client.ObjectStorage().GetBucket(ctx, bucketName)
+		},
+		State: func(bucket *objectstorage.ObjectStorageBucket) string { return bucket.Status },
+	}
+}
+
+func waitForReadyBucket(ctx context.Context, client thalassa.Client, bucketName string) error {
+	w := bucketWaiter(client, bucketName)
+	w.Target = []string{"ready"}
+	_, err := w.Wait(ctx)
+	return err
+}
+
+func waitForDeletedBucket(ctx context.Context, client thalassa.Client, bucketName string) error {
+	w := bucketWaiter(client, bucketName)
+	w.Failed = []string{}
+	w.TargetNotFound = true
+	_, err := w.Wait(ctx)
+	return err
+}
+
+const (
+	// bucketLifecycleRulesWait bounds the wait for lifecycle rules, which a bucket without rules never reports.
+	bucketLifecycleRulesWait = 20 * time.Second
+
+	bucketLifecycleRulesPresent = "present"
+	bucketLifecycleRulesAbsent  = "absent"
+)
+
+// waitForBucketLifecycleRules waits until the lifecycle rules of the bucket are reported, as they are not reported
+// right after they were set. When no rules are reported within bucketLifecycleRulesWait, the bucket has none and the
+// empty lifecycle is returned.
+func waitForBucketLifecycleRules(ctx context.Context, client thalassa.Client, bucketName string) (*objectstorage.BucketLifecycle, error) {
+	waitCtx, cancel := context.WithTimeout(ctx, bucketLifecycleRulesWait)
+	defer cancel()
+
+	var last *objectstorage.BucketLifecycle
+	lifecycle, err := waiter.Waiter[*objectstorage.BucketLifecycle]{
+		Description: fmt.Sprintf("lifecycle rules of bucket %q", bucketName),
+		Refresh: func(ctx context.Context) (*objectstorage.BucketLifecycle, error) {
+			lifecycle, err := readBucketLifecycle(ctx, client, bucketName)
+			if err == nil {
+				last = lifecycle
+			}
+			return lifecycle, err
+		},
+		State: func(lifecycle *objectstorage.BucketLifecycle) string {
+			if len(lifecycle.Rules) > 0 {
+				return bucketLifecycleRulesPresent
+			}
+			return bucketLifecycleRulesAbsent
+		},
+		Target:      []string{bucketLifecycleRulesPresent},
+		MaxInterval: 4 * time.Second,
+	}.Wait(waitCtx)
+
+	var waitErr *waiter.Error
+	if errors.As(err, &waitErr) && waitErr.Timeout && ctx.Err() == nil {
+		return last, nil
+	}
+	return lifecycle, err
+}
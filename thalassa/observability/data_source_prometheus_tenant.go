@@ -0,0 +1,167 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/thalassa-cloud/client-go/observability/prometheus"
+	tcclient "github.com/thalassa-cloud/client-go/pkg/client"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+func DataSourcePrometheusTenant() *schema.Resource {
+	return &schema.Resource{
+		Description: "Look up a managed Prometheus tenant by identity or name",
+		ReadContext: dataSourcePrometheusTenantRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				Description:  "Platform identity of the Prometheus tenant.",
+			},
+			"organisation_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Organisation ID. Defaults to the provider organisation.",
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Project ID. Defaults to the provider project.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				Description:  "Name of the tenant (used when id is not set).",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Region slug of the tenant.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Human-readable description.",
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Labels for the tenant.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Annotations for the tenant.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"retention": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Retention period for metrics in Prometheus duration format.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the tenant.",
+			},
+			"status_message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Additional information about the status of the tenant.",
+			},
+			"remote_write_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Prometheus remote write endpoint.",
+			},
+			"remote_write_otlp_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "OTLP metrics endpoint.",
+			},
+			"query_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Prometheus-compatible query endpoint.",
+			},
+			"alerting_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Alerting endpoint.",
+			},
+			"delete_scheduled_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time at which the tenant and its data are permanently deleted (RFC3339), if deletion was requested.",
+			},
+			"object_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Platform object version for optimistic concurrency.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation timestamp (RFC3339).",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last update timestamp (RFC3339).",
+			},
+		},
+	}
+}
+
+func dataSourcePrometheusTenantRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var tenant *prometheus.PrometheusTenant
+	if id, ok := d.GetOk("id"); ok && id.(string) != "" {
+		tenant, err = client.ObservabilityPrometheus().GetPrometheusTenant(ctx, id.(string))
+		if err != nil {
+			if tcclient.IsNotFound(err) {
+				return diag.Errorf("prometheus tenant %s not found", id.(string))
+			}
+			return diag.FromErr(fmt.Errorf("reading prometheus tenant: %w", err))
+		}
+	} else {
+		name := d.Get("name").(string)
+		tenants, err := client.ObservabilityPrometheus().ListPrometheusTenants(ctx, &prometheus.ListPrometheusTenantsRequest{})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("listing prometheus tenants: %w", err))
+		}
+		for i := range tenants {
+			if tenants[i].Name == name {
+				tenant = &tenants[i]
+				break
+			}
+		}
+	}
+
+	if tenant == nil {
+		return diag.Errorf("prometheus tenant not found")
+	}
+
+	d.SetId(tenant.Identity)
+	if err := setPrometheusTenantState(d, tenant); err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("region", convert.RegionStateValue(tenant.Region, ""))
+
+	return nil
+}
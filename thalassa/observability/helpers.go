@@ -0,0 +1,83 @@
+package observability
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/thalassa-cloud/client-go/observability/prometheus"
+)
+
+// Retention bounds enforced by the Prometheus tenant API.
+const (
+	minRetentionHours = 24
+	maxRetentionHours = 3 * 365 * 24
+)
+
+var retentionRegexp = regexp.MustCompile(`^([1-9]\d*)([hdwy])$`)
+
+// validateRetention checks that v is a Prometheus duration (e.g. 30d, 1y) between 1 day and 3 years.
+func validateRetention(v any, k string) (warnings []string, errs []error) {
+	value, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected %q to be a string", k)}
+	}
+
+	matches := retentionRegexp.FindStringSubmatch(value)
+	if matches == nil {
+		return nil, []error{fmt.Errorf("%q must be a Prometheus duration of the form <number><unit> with unit h, d, w or y (e.g. 30d, 1y), got %q", k, value)}
+	}
+
+	number, err := strconv.ParseInt(matches[1], 10, 64)
+	if err != nil {
+		return nil, []error{fmt.Errorf("%q has an invalid number: %w", k, err)}
+	}
+
+	hours := number
+	switch matches[2] {
+	case "d":
+		hours *= 24
+	case "w":
+		hours *= 24 * 7
+	case "y":
+		hours *= 24 * 365
+	}
+
+	if hours < minRetentionHours {
+		errs = append(errs, fmt.Errorf("%q must be at least 1 day, got %s", k, value))
+	}
+	if hours > maxRetentionHours {
+		errs = append(errs, fmt.Errorf("%q must not exceed 3 years, got %s", k, value))
+	}
+	return nil, errs
+}
+
+func setPrometheusTenantState(d interface {
+	Set(string, any) error
+}, tenant *prometheus.PrometheusTenant) error {
+	_ = d.Set("name", tenant.Name)
+	_ = d.Set("description", tenant.Description)
+	_ = d.Set("labels", tenant.Labels)
+	_ = d.Set("annotations", tenant.Annotations)
+	_ = d.Set("retention", tenant.Retention)
+	_ = d.Set("status", string(tenant.Status))
+	_ = d.Set("status_message", tenant.StatusMessage)
+	_ = d.Set("remote_write_url", tenant.RemoteWriteURL)
+	_ = d.Set("remote_write_otlp_url", tenant.RemoteWriteOTLPURL)
+	_ = d.Set("query_url", tenant.QueryURL)
+	_ = d.Set("alerting_url", tenant.AlertingURL)
+	_ = d.Set("object_version", tenant.ObjectVersion)
+	if !tenant.CreatedAt.IsZero() {
+		_ = d.Set("created_at", tenant.CreatedAt.Format(time.RFC3339))
+	}
+	if !tenant.UpdatedAt.IsZero() {
+		_ = d.Set("updated_at", tenant.UpdatedAt.Format(time.RFC3339))
+	}
+	if tenant.DeleteScheduledAt != nil {
+		_ = d.Set("delete_scheduled_at", tenant.DeleteScheduledAt.Format(time.RFC3339))
+	} else {
+		_ = d.Set("delete_scheduled_at", "")
+	}
+	return nil
+}
@@ -0,0 +1,274 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validate "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	iaas "github.com/thalassa-cloud/client-go/iaas"
+	"github.com/thalassa-cloud/client-go/observability/prometheus"
+	tcclient "github.com/thalassa-cloud/client-go/pkg/client"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+func ResourcePrometheusTenant() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Create and manage a managed Prometheus tenant in Thalassa Cloud",
+		CreateContext: resourcePrometheusTenantCreate,
+		ReadContext:   resourcePrometheusTenantRead,
+		UpdateContext: resourcePrometheusTenantUpdate,
+		DeleteContext: resourcePrometheusTenantDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Platform identity of the Prometheus tenant.",
+			},
+			"organisation_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Organisation ID. Defaults to the provider organisation.",
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Project ID. Defaults to the provider project.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.StringLenBetween(1, 62),
+				Description:  "Name of the Prometheus tenant.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.StringLenBetween(0, 255),
+				Description:  "Human-readable description.",
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Labels for the tenant.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"annotations": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Annotations for the tenant.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Region of the tenant (slug or identity, e.g. nl-01).",
+			},
+			"retention": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateRetention,
+				Description:  "Retention period for metrics in Prometheus duration format, between 1d and 3y (e.g. 30d, 13w, 1y). Defaults to the platform default when not set.",
+			},
+			"wait_until_ready": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Wait until the tenant is ready and its endpoints are available.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the tenant.",
+			},
+			"status_message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Additional information about the status of the tenant.",
+			},
+			"remote_write_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Prometheus remote write endpoint.",
+			},
+			"remote_write_otlp_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "OTLP metrics endpoint.",
+			},
+			"query_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Prometheus-compatible query endpoint.",
+			},
+			"alerting_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Alerting endpoint.",
+			},
+			"delete_scheduled_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time at which the tenant and its data are permanently deleted (RFC3339). Set after deletion has been requested.",
+			},
+			"object_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Platform object version for optimistic concurrency.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation timestamp (RFC3339).",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last update timestamp (RFC3339).",
+			},
+		},
+	}
+}
+
+func resourcePrometheusTenantCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := d.Get("region").(string)
+	regions, err := client.IaaS().ListRegions(ctx, &iaas.ListRegionsRequest{})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("listing regions: %w", err))
+	}
+	found := false
+	for _, r := range regions {
+		if r.Identity == region || r.Slug == region || r.Name == region {
+			region = r.Identity
+			found = true
+			break
+		}
+	}
+	if !found {
+		available := make([]string, len(regions))
+		for i, r := range regions {
+			available[i] = r.Slug
+		}
+		return diag.Errorf("region not found: %s. Available regions: %s", region, strings.Join(available, ", "))
+	}
+
+	tenant, err := client.ObservabilityPrometheus().CreatePrometheusTenant(ctx, prometheus.CreatePrometheusTenantRequest{
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		Labels:         convert.ConvertToMap(d.Get("labels")),
+		Annotations:    convert.ConvertToMap(d.Get("annotations")),
+		RegionIdentity: region,
+		Retention:      d.Get("retention").(string),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("creating prometheus tenant: %w", err))
+	}
+
+	d.SetId(tenant.Identity)
+
+	if d.Get("wait_until_ready").(bool) {
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+		defer cancel()
+		if _, err := waitForReadyPrometheusTenant(ctxWithTimeout, client, tenant.Identity); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourcePrometheusTenantRead(ctx, d, m)
+}
+
+func resourcePrometheusTenantRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tenant, err := client.ObservabilityPrometheus().GetPrometheusTenant(ctx, d.Id())
+	if err != nil {
+		if tcclient.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("reading prometheus tenant: %w", err))
+	}
+	if tenant == nil || tenant.Status == prometheus.PrometheusTenantStatusDeleted {
+		d.SetId("")
+		return nil
+	}
+
+	if err := setPrometheusTenantState(d, tenant); err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("region", convert.RegionStateValue(tenant.Region, d.Get("region").(string)))
+
+	return nil
+}
+
+func resourcePrometheusTenantUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.ObservabilityPrometheus().UpdatePrometheusTenant(ctx, d.Id(), prometheus.UpdatePrometheusTenantRequest{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Labels:      convert.ConvertToMap(d.Get("labels")),
+		Annotations: convert.ConvertToMap(d.Get("annotations")),
+		Retention:   d.Get("retention").(string),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("updating prometheus tenant: %w", err))
+	}
+
+	if d.Get("wait_until_ready").(bool) && d.HasChange("retention") {
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+		defer cancel()
+		if _, err := waitForReadyPrometheusTenant(ctxWithTimeout, client, d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourcePrometheusTenantRead(ctx, d, m)
+}
+
+func resourcePrometheusTenantDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The platform schedules the tenant for deletion after a grace period, so there is nothing to wait for.
+	if err := client.ObservabilityPrometheus().DeletePrometheusTenant(ctx, d.Id()); err != nil {
+		if tcclient.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("deleting prometheus tenant: %w", err))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,11 @@
+package observability
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+var ResourcesMap = map[string]*schema.Resource{
+	"thalassa_observability_prometheus_tenant": ResourcePrometheusTenant(),
+}
+
+var DataSourcesMap = map[string]*schema.Resource{
+	"thalassa_observability_prometheus_tenant": DataSourcePrometheusTenant(),
+}
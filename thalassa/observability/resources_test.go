@@ -0,0 +1,30 @@
+package observability
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourcePrometheusTenant(t *testing.T) {
+	resource := ResourcePrometheusTenant()
+	schema := resource.Schema
+
+	assert.True(t, schema["name"].Required)
+	assert.True(t, schema["region"].ForceNew)
+	assert.False(t, schema["retention"].ForceNew)
+	assert.True(t, schema["remote_write_url"].Computed)
+	assert.NotNil(t, resource.Importer)
+	assert.NotNil(t, resource.Timeouts)
+}
+
+func TestValidateRetention(t *testing.T) {
+	for _, valid := range []string{"24h", "1d", "30d", "13w", "1y", "3y", "1095d"} {
+		_, errs := validateRetention(valid, "retention")
+		assert.Empty(t, errs, valid)
+	}
+	for _, invalid := range []string{"", "12h", "0d", "30", "1m", "4y", "1096d", "7 d"} {
+		_, errs := validateRetention(invalid, "retention")
+		assert.NotEmpty(t, errs, invalid)
+	}
+}
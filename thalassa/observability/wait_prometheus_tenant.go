@@ -0,0 +1,24 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thalassa-cloud/client-go/observability/prometheus"
+	"github.com/thalassa-cloud/client-go/thalassa"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/waiter"
+)
+
+func waitForReadyPrometheusTenant(ctx context.Context, client thalassa.Client, tenantID string) (*prometheus.PrometheusTenant, error) {
+	return waiter.Waiter[*prometheus.PrometheusTenant]{
+		Description: fmt.Sprintf("prometheus tenant %q", tenantID),
+		Refresh: func(ctx context.Context) (*prometheus.PrometheusTenant, error) {
+			return client.ObservabilityPrometheus().GetPrometheusTenant(ctx, tenantID)
+		},
+		State:   func(tenant *prometheus.PrometheusTenant) string { return string(tenant.Status) },
+		Message: func(tenant *prometheus.PrometheusTenant) string { return tenant.StatusMessage },
+		Target:  []string{string(prometheus.PrometheusTenantStatusReady)},
+		Failed:  []string{string(prometheus.PrometheusTenantStatusFailed)},
+	}.Wait(ctx)
+}
@@ -0,0 +1,56 @@
+package organisation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	tcclient "github.com/thalassa-cloud/client-go/pkg/client"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+func DataSourceOrganisationQuota() *schema.Resource {
+	s := quotaSchema()
+	s["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Name of the quota",
+	}
+	s["organisation_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Organisation ID. Defaults to the provider organisation.",
+	}
+	return &schema.Resource{
+		Description: "Get a quota of an organisation and its current usage",
+		ReadContext: dataSourceOrganisationQuotaRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceOrganisationQuotaRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	quota, err := client.Quotas().GetOrganisationQuota(ctx, name)
+	if err != nil {
+		if tcclient.IsNotFound(err) {
+			return diag.Errorf("quota %s not found", name)
+		}
+		return diag.FromErr(fmt.Errorf("reading quota: %w", err))
+	}
+
+	d.SetId(quota.Name)
+	for k, v := range flattenQuota(*quota) {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package organisation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+func DataSourceOrganisationQuotas() *schema.Resource {
+	quota := quotaSchema()
+	quota["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Name of the quota",
+	}
+	return &schema.Resource{
+		Description: "List the quotas of an organisation and their current usage",
+		ReadContext: dataSourceOrganisationQuotasRead,
+		Schema: map[string]*schema.Schema{
+			"organisation_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Organisation ID. Defaults to the provider organisation.",
+			},
+			"service": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return quotas of this service.",
+			},
+			"quotas": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Quotas of the organisation, sorted by name.",
+				Elem:        &schema.Resource{Schema: quota},
+			},
+		},
+	}
+}
+
+func dataSourceOrganisationQuotasRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	quotas, err := client.Quotas().ListOrganisationQuotas(ctx)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("listing quotas: %w", err))
+	}
+
+	service := d.Get("service").(string)
+	result := make([]map[string]any, 0, len(quotas))
+	for _, quota := range quotas {
+		if service != "" && (quota.Service == nil || *quota.Service != service) {
+			continue
+		}
+		result = append(result, flattenQuota(quota))
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i]["name"].(string) < result[j]["name"].(string)
+	})
+
+	organisation := d.Get("organisation_id").(string)
+	if organisation == "" {
+		organisation = provider.GetProvider(m).Organisation
+	}
+	d.SetId(fmt.Sprintf("%s/quotas", organisation))
+	if err := d.Set("quotas", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
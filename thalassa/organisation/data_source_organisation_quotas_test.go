@@ -0,0 +1,31 @@
+package organisation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/thalassa-cloud/client-go/quotas"
+)
+
+func TestFlattenQuota(t *testing.T) {
+	service := "iaas"
+	quota := quotas.OrganisationQuota{
+		Name:         "block_storage_gb",
+		Service:      &service,
+		MaxUsage:     500,
+		CurrentUsage: 120,
+		IncreaseRequests: []quotas.IncreaseOrganisationQuotaRequest{
+			{Decision: "pending"},
+			{Decision: "approved"},
+		},
+	}
+
+	flat := flattenQuota(quota)
+	assert.Equal(t, "iaas", flat["service"])
+	assert.Equal(t, int64(380), flat["remaining"])
+	assert.Equal(t, 1, flat["pending_increase_requests"])
+
+	quota.CurrentUsage = 600
+	assert.Equal(t, int64(0), flattenQuota(quota)["remaining"])
+}
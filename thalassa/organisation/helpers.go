@@ -0,0 +1,71 @@
+package organisation
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/thalassa-cloud/client-go/quotas"
+)
+
+// quotaSchema returns the computed attributes of an organisation quota.
+func quotaSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"description": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Description of the quota",
+		},
+		"service": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Service the quota applies to",
+		},
+		"quota_type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Type of the quota",
+		},
+		"max_usage": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Maximum usage allowed by the quota",
+		},
+		"current_usage": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Current usage of the quota",
+		},
+		"remaining": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Remaining capacity of the quota (`max_usage` minus `current_usage`, never negative)",
+		},
+		"pending_increase_requests": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of quota increase requests awaiting a decision",
+		},
+	}
+}
+
+func flattenQuota(quota quotas.OrganisationQuota) map[string]any {
+	service := ""
+	if quota.Service != nil {
+		service = *quota.Service
+	}
+	pending := 0
+	for _, request := range quota.IncreaseRequests {
+		if request.Decision == "" || request.Decision == "pending" {
+			pending++
+		}
+	}
+	return map[string]any{
+		"name":                      quota.Name,
+		"description":               quota.Description,
+		"service":                   service,
+		"quota_type":                quota.QuotaType,
+		"max_usage":                 quota.MaxUsage,
+		"current_usage":             quota.CurrentUsage,
+		"remaining":                 max(quota.MaxUsage-quota.CurrentUsage, 0),
+		"pending_increase_requests": pending,
+	}
+}
@@ -6,6 +6,8 @@ var (
 	ResourcesMap = map[string]*schema.Resource{}
 
 	DataSourcesMap = map[string]*schema.Resource{
-		"thalassa_organisation": DataSourceOrganisations(),
+		"thalassa_organisation":        DataSourceOrganisations(),
+		"thalassa_organisation_quota":  DataSourceOrganisationQuota(),
+		"thalassa_organisation_quotas": DataSourceOrganisationQuotas(),
 	}
 )
@@ -0,0 +1,130 @@
+package projects
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	tcclient "github.com/thalassa-cloud/client-go/pkg/client"
+	tcprojects "github.com/thalassa-cloud/client-go/projects"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+func DataSourceProject() *schema.Resource {
+	return &schema.Resource{
+		Description: "Look up a project by identity, slug or name",
+		ReadContext: dataSourceProjectRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "slug", "name"},
+				Description:  "Platform identity of the project.",
+			},
+			"organisation_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Organisation ID. Defaults to the provider organisation.",
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "slug", "name"},
+				Description:  "Slug of the project.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "slug", "name"},
+				Description:  "Name of the project.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Human-readable description.",
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Labels for the project.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Annotations for the project.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"parent_project_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identity of the parent project, if any.",
+			},
+			"object_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Platform object version for optimistic concurrency.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation timestamp (RFC3339).",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last update timestamp (RFC3339).",
+			},
+		},
+	}
+}
+
+func dataSourceProjectRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var project *tcprojects.Project
+	identity := d.Get("id").(string)
+	if identity == "" {
+		identity = d.Get("slug").(string)
+	}
+
+	if identity != "" {
+		project, err = client.Projects().GetProject(ctx, identity)
+		if err != nil {
+			if tcclient.IsNotFound(err) {
+				return diag.Errorf("project %s not found", identity)
+			}
+			return diag.FromErr(fmt.Errorf("reading project: %w", err))
+		}
+	} else {
+		name := d.Get("name").(string)
+		projects, err := client.Projects().ListProjects(ctx, &tcprojects.ListProjectsRequest{})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("listing projects: %w", err))
+		}
+		for i := range projects {
+			if projects[i].Name == name {
+				project = &projects[i]
+				break
+			}
+		}
+		if project == nil {
+			return diag.Errorf("project with name %s not found", name)
+		}
+	}
+
+	d.SetId(project.Identity)
+	if err := setProjectState(d, project); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
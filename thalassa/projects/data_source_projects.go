@@ -0,0 +1,136 @@
+package projects
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/thalassa-cloud/client-go/filters"
+	tcprojects "github.com/thalassa-cloud/client-go/projects"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+func DataSourceProjects() *schema.Resource {
+	return &schema.Resource{
+		Description: "List the projects of an organisation",
+		ReadContext: dataSourceProjectsRead,
+		Schema: map[string]*schema.Schema{
+			"organisation_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Organisation ID. Defaults to the provider organisation.",
+			},
+			"label_selector": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Only return projects that have all of these labels.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"parent_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return direct children of this project.",
+			},
+			"projects": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Projects matching the filters.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Platform identity of the project.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the project.",
+						},
+						"slug": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Slug of the project.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Human-readable description.",
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "Labels for the project.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"annotations": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "Annotations for the project.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"parent_project_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Identity of the parent project, if any.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceProjectsRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var requestFilters []filters.Filter
+	labels := convert.ConvertToMap(d.Get("label_selector"))
+	if len(labels) > 0 {
+		requestFilters = append(requestFilters, &filters.LabelFilter{
+			MatchLabels: labels,
+		})
+	}
+
+	projects, err := client.Projects().ListProjects(ctx, &tcprojects.ListProjectsRequest{
+		Filters: requestFilters,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("listing projects: %w", err))
+	}
+
+	parent := d.Get("parent_project_id").(string)
+	matched := make([]tcprojects.Project, 0, len(projects))
+	for _, project := range projects {
+		if !projectHasLabels(project, labels) {
+			continue
+		}
+		if parent != "" && (project.ParentProject == nil || project.ParentProject.Identity != parent) {
+			continue
+		}
+		matched = append(matched, project)
+	}
+
+	d.SetId(fmt.Sprintf("%s/projects", provider.GetProvider(m).Organisation))
+	if err := d.Set("projects", flattenProjects(matched)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// projectHasLabels re-applies the label selector in case the API ignores it.
+func projectHasLabels(project tcprojects.Project, labels map[string]string) bool {
+	for k, v := range labels {
+		if project.Labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
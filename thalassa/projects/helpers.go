@@ -0,0 +1,66 @@
+package projects
+
+import (
+	"fmt"
+	"time"
+
+	tcprojects "github.com/thalassa-cloud/client-go/projects"
+)
+
+func setProjectState(d interface {
+	Set(string, any) error
+}, project *tcprojects.Project) error {
+	values := map[string]any{
+		"name":              project.Name,
+		"slug":              project.Slug,
+		"description":       project.Description,
+		"labels":            project.Labels,
+		"annotations":       project.Annotations,
+		"object_version":    project.ObjectVersion,
+		"parent_project_id": "",
+	}
+	if project.ParentProject != nil {
+		values["parent_project_id"] = project.ParentProject.Identity
+	}
+	if !project.CreatedAt.IsZero() {
+		values["created_at"] = project.CreatedAt.Format(time.RFC3339)
+	}
+	if project.UpdatedAt != nil {
+		values["updated_at"] = project.UpdatedAt.Format(time.RFC3339)
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return fmt.Errorf("setting %s: %w", key, err)
+		}
+	}
+	return nil
+}
+
+func flattenProjects(projects []tcprojects.Project) []map[string]any {
+	result := make([]map[string]any, 0, len(projects))
+	for _, project := range projects {
+		item := map[string]any{
+			"id":                project.Identity,
+			"name":              project.Name,
+			"slug":              project.Slug,
+			"description":       project.Description,
+			"labels":            project.Labels,
+			"annotations":       project.Annotations,
+			"parent_project_id": "",
+		}
+		if project.ParentProject != nil {
+			item["parent_project_id"] = project.ParentProject.Identity
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+func parentProjectIdentity(v any) *string {
+	s, ok := v.(string)
+	if !ok || s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,177 @@
+package projects
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validate "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	tcclient "github.com/thalassa-cloud/client-go/pkg/client"
+	tcprojects "github.com/thalassa-cloud/client-go/projects"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+func ResourceProject() *schema.Resource {
+	return provider.WithIDIdentity(&schema.Resource{
+		Description:   "Create and manage a project within a Thalassa Cloud organisation",
+		CreateContext: resourceProjectCreate,
+		ReadContext:   resourceProjectRead,
+		UpdateContext: resourceProjectUpdate,
+		DeleteContext: resourceProjectDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Platform identity of the project.",
+			},
+			"organisation_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Organisation ID. Defaults to the provider organisation.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.StringLenBetween(1, 62),
+				Description:  "Name of the project.",
+			},
+			"slug": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL-safe slug of the project.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.StringLenBetween(0, 255),
+				Description:  "Human-readable description.",
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Labels for the project.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"annotations": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Annotations for the project.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"parent_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Identity of the parent project, for nested projects.",
+			},
+			"object_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Platform object version for optimistic concurrency.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation timestamp (RFC3339).",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last update timestamp (RFC3339).",
+			},
+		},
+	})
+}
+
+func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	project, err := client.Projects().CreateProject(ctx, tcprojects.CreateProjectRequest{
+		Name:                  d.Get("name").(string),
+		Description:           d.Get("description").(string),
+		Labels:                convert.ConvertToMap(d.Get("labels")),
+		Annotations:           convert.ConvertToMap(d.Get("annotations")),
+		ParentProjectIdentity: parentProjectIdentity(d.Get("parent_project_id")),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("creating project: %w", err))
+	}
+
+	d.SetId(project.Identity)
+	if err := setProjectState(d, project); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceProjectRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	project, err := client.Projects().GetProject(ctx, d.Id())
+	if err != nil {
+		if tcclient.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("reading project: %w", err))
+	}
+
+	if err := setProjectState(d, project); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	project, err := client.Projects().UpdateProject(ctx, d.Id(), tcprojects.UpdateProjectRequest{
+		Name:                  d.Get("name").(string),
+		Description:           d.Get("description").(string),
+		Labels:                convert.ConvertToMap(d.Get("labels")),
+		Annotations:           convert.ConvertToMap(d.Get("annotations")),
+		ParentProjectIdentity: parentProjectIdentity(d.Get("parent_project_id")),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("updating project: %w", err))
+	}
+
+	if err := setProjectState(d, project); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.Projects().DeleteProject(ctx, d.Id()); err != nil {
+		if tcclient.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("deleting project: %w", err))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,48 @@
+package projects
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	tcprojects "github.com/thalassa-cloud/client-go/projects"
+)
+
+func TestResourceProject(t *testing.T) {
+	resource := ResourceProject()
+	schema := resource.Schema
+
+	assert.True(t, schema["name"].Required)
+	assert.False(t, schema["name"].ForceNew)
+	assert.True(t, schema["slug"].Computed)
+	assert.Nil(t, schema["project_id"])
+	assert.NotNil(t, resource.Importer)
+	assert.NotNil(t, resource.Identity)
+}
+
+func TestSetProjectState(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceProject().Schema, map[string]any{})
+	project := &tcprojects.Project{
+		Identity:      "prj-abc123",
+		Name:          "payments",
+		Slug:          "payments",
+		ParentProject: &tcprojects.ProjectRef{Identity: "prj-parent"},
+	}
+
+	assert.NoError(t, setProjectState(d, project))
+	assert.Equal(t, "payments", d.Get("slug"))
+	assert.Equal(t, "prj-parent", d.Get("parent_project_id"))
+}
+
+func TestProjectHasLabels(t *testing.T) {
+	project := tcprojects.Project{Labels: map[string]string{"team": "payments", "env": "prod"}}
+	assert.True(t, projectHasLabels(project, nil))
+	assert.True(t, projectHasLabels(project, map[string]string{"team": "payments"}))
+	assert.False(t, projectHasLabels(project, map[string]string{"team": "search"}))
+}
+
+func TestParentProjectIdentity(t *testing.T) {
+	assert.Nil(t, parentProjectIdentity(""))
+	assert.Equal(t, "prj-parent", *parentProjectIdentity("prj-parent"))
+}
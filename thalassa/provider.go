@@ -5,6 +5,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/audit"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/containerregistry"
 	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/dbaas"
 	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/dns"
 	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/iaas"
@@ -12,8 +14,11 @@ import (
 	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/kms"
 	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/kubernetes"
 	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/objectstorage"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/observability"
 	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/organisation"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/projects"
 	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/quicklaunch"
 	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/secrets"
 	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/tfs"
 )
@@ -52,9 +57,60 @@ func Provider() *schema.Provider {
 			"allow_insecure_oidc": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "Allow insecure OIDC authentication. Can be set via the THALASSA_ALLOW_INSECURE_OIDC environment variable.",
+				Description: "Allow insecure OIDC authentication: the TLS certificate of the OIDC token endpoint is not verified. Can be set via the THALASSA_ALLOW_INSECURE_OIDC environment variable.",
 				DefaultFunc: schema.EnvDefaultFunc("THALASSA_ALLOW_INSECURE_OIDC", false),
 			},
+			"oidc_token": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"oidc_token_file"},
+				Description:   "An external OIDC token (JWT), e.g. a CI job ID token, to exchange for an access token through a federated identity. Requires `service_account_id`. Can be set via the THALASSA_OIDC_TOKEN environment variable.",
+				DefaultFunc:   schema.EnvDefaultFunc("THALASSA_OIDC_TOKEN", nil),
+			},
+			"oidc_token_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"oidc_token"},
+				Description:   "Path to a file containing an external OIDC token (JWT) to exchange for an access token through a federated identity. The file is re-read on every exchange, so rotated tokens are picked up. Requires `service_account_id`. Can be set via the THALASSA_OIDC_TOKEN_FILE environment variable.",
+				DefaultFunc:   schema.EnvDefaultFunc("THALASSA_OIDC_TOKEN_FILE", nil),
+			},
+			"service_account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The service account to act as when exchanging an external OIDC token. Can be set via the THALASSA_SERVICE_ACCOUNT_ID environment variable.",
+				DefaultFunc: schema.EnvDefaultFunc("THALASSA_SERVICE_ACCOUNT_ID", nil),
+			},
+			"access_token_lifetime": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Requested lifetime of access tokens obtained through OIDC token exchange, as a duration (e.g. `1h`). Tokens are renewed automatically shortly before they expire. Can be set via the THALASSA_ACCESS_TOKEN_LIFETIME environment variable.",
+				DefaultFunc: schema.EnvDefaultFunc("THALASSA_ACCESS_TOKEN_LIFETIME", nil),
+			},
+			"quota_preflight": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Fail the plan when the virtual machines, block volumes, Kubernetes node pools and reserved IPs planned for creation would exceed the remaining organisation quota. Can be set via the THALASSA_QUOTA_PREFLIGHT environment variable.",
+				DefaultFunc: schema.EnvDefaultFunc("THALASSA_QUOTA_PREFLIGHT", false),
+			},
+			"max_retries": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Maximum number of times an idempotent API request (GET, PUT or DELETE) is retried after a rate limit (429) response, a server error (500, 502, 503 or 504) or a network error. Set to 0 to disable retries. Defaults to 3. Can be set via the THALASSA_MAX_RETRIES environment variable.",
+				DefaultFunc: schema.EnvDefaultFunc("THALASSA_MAX_RETRIES", provider.DefaultMaxRetries),
+			},
+			"retry_max_wait": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Maximum time to wait between retries, as a duration (e.g. `30s`). The `Retry-After` header of rate limit responses is honoured up to this limit. Defaults to `30s`. Can be set via the THALASSA_RETRY_MAX_WAIT environment variable.",
+				DefaultFunc: schema.EnvDefaultFunc("THALASSA_RETRY_MAX_WAIT", provider.DefaultRetryMaxWait.String()),
+			},
+			"requests_per_second": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Description: "Maximum number of API requests per second, shared by all concurrent operations of the provider. Defaults to 0, which does not limit the request rate. Can be set via the THALASSA_REQUESTS_PER_SECOND environment variable.",
+				DefaultFunc: schema.EnvDefaultFunc("THALASSA_REQUESTS_PER_SECOND", 0.0),
+			},
 			"api": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -85,6 +141,11 @@ func Provider() *schema.Provider {
 			secrets.ResourcesMap,
 			objectstorage.ResourcesMap,
 			tfs.ResourcesMap,
+			containerregistry.ResourcesMap,
+			observability.ResourcesMap,
+			projects.ResourcesMap,
+			audit.ResourcesMap,
+			quicklaunch.ResourcesMap,
 		),
 		DataSourcesMap: JoinMaps(
 			iaas.DataSourcesMap,
@@ -95,6 +156,11 @@ func Provider() *schema.Provider {
 			kms.DataSourcesMap,
 			objectstorage.DataSourcesMap,
 			tfs.DataSourcesMap,
+			containerregistry.DataSourcesMap,
+			observability.DataSourcesMap,
+			projects.DataSourcesMap,
+			audit.DataSourcesMap,
+			quicklaunch.DataSourcesMap,
 		),
 		ConfigureContextFunc: provider.ProviderConfigure,
 	}
@@ -107,3 +173,11 @@ func JoinMaps(maps ...map[string]*schema.Resource) map[string]*schema.Resource {
 	}
 	return result
 }
+
+func JoinSlices[T any](slices ...[]T) []T {
+	var result []T
+	for _, s := range slices {
+		result = append(result, s...)
+	}
+	return result
+}
@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
+	"github.com/thalassa-cloud/client-go/thalassa"
+)
+
+const (
+	// tokenRefreshMargin is how long before their expiry OIDC access tokens are refreshed, so requests that are
+	// still in flight, or retried, do not run into an expired token.
+	tokenRefreshMargin = time.Minute
+	// tokenRequestTimeout bounds a single token request, which is not tied to the context of an operation.
+	tokenRequestTimeout = 30 * time.Second
+
+	userAgent                  = "thalassa-cloud/terraform-provider-thalassa"
+	oidcGrantTypeTokenExchange = "urn:ietf:params:oauth:grant-type:token-exchange"
+	oidcTokenTypeJWT           = "urn:ietf:params:oauth:token-type:jwt"
+)
+
+type clientKey struct {
+	organisation string
+	project      string
+}
+
+// clientCache holds one client per organisation and project, so resource operations do not create a new client,
+// and authenticate again, for every request.
+type clientCache struct {
+	mu      sync.Mutex
+	clients map[clientKey]thalassa.Client
+}
+
+func newClientCache() *clientCache {
+	return &clientCache{clients: map[clientKey]thalassa.Client{}}
+}
+
+// client returns the cached client for the organisation and project, creating it when needed.
+func (provider ConfiguredProvider) client(organisation, project string) (thalassa.Client, error) {
+	if provider.clients == nil {
+		return provider.newClient(organisation, project)
+	}
+
+	provider.clients.mu.Lock()
+	defer provider.clients.mu.Unlock()
+
+	key := clientKey{organisation: organisation, project: project}
+	if c, ok := provider.clients.clients[key]; ok {
+		return c, nil
+	}
+	c, err := provider.newClient(organisation, project)
+	if err != nil {
+		return nil, err
+	}
+	provider.clients.clients[key] = c
+	return c, nil
+}
+
+// tokenSources holds the OIDC token sources shared by all clients. Client credentials tokens are not bound to an
+// organisation and are shared by all clients, exchanged tokens are shared by the clients of an organisation.
+type tokenSources struct {
+	mu              sync.Mutex
+	sources         map[string]oauth2.TokenSource
+	perOrganisation bool
+	newSource       func(organisation string) oauth2.TokenSource
+}
+
+// get returns the token source for the organisation. Tokens are refreshed tokenRefreshMargin before they expire.
+func (s *tokenSources) get(organisation string) oauth2.TokenSource {
+	if !s.perOrganisation {
+		organisation = ""
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if source, ok := s.sources[organisation]; ok {
+		return source
+	}
+	source := oauth2.ReuseTokenSourceWithExpiry(nil, s.newSource(organisation), tokenRefreshMargin)
+	s.sources[organisation] = source
+	return source
+}
+
+// newTokenSources returns the token sources for OIDC client credentials or token exchange authentication, or nil
+// when neither is configured. Token exchange takes precedence, as it did when both were passed to the client.
+func (provider ConfiguredProvider) newTokenSources() *tokenSources {
+	tokenURL := fmt.Sprintf("%s/oidc/token", provider.apiEndpoint)
+	httpClient := provider.tokenHTTPClient()
+
+	switch {
+	case provider.serviceAccountID != "" && (provider.oidcToken != "" || provider.oidcTokenFile != ""):
+		return &tokenSources{
+			sources:         map[string]oauth2.TokenSource{},
+			perOrganisation: true,
+			newSource: func(organisation string) oauth2.TokenSource {
+				return &tokenExchangeSource{
+					httpClient:          httpClient,
+					tokenURL:            tokenURL,
+					subjectToken:        provider.oidcToken,
+					subjectTokenFile:    provider.oidcTokenFile,
+					organisation:        organisation,
+					serviceAccountID:    provider.serviceAccountID,
+					accessTokenLifetime: provider.accessTokenLifetime,
+				}
+			},
+		}
+	case provider.clientID != "" && provider.clientSecret != "":
+		config := clientcredentials.Config{
+			ClientID:     provider.clientID,
+			ClientSecret: provider.clientSecret,
+			TokenURL:     tokenURL,
+		}
+		return &tokenSources{
+			sources: map[string]oauth2.TokenSource{},
+			newSource: func(string) oauth2.TokenSource {
+				return config.TokenSource(context.WithValue(context.Background(), oauth2.HTTPClient, httpClient))
+			},
+		}
+	}
+	return nil
+}
+
+// tokenHTTPClient returns the HTTP client for token requests. Token requests share the rate limit of API requests.
+// With allow_insecure_oidc the certificate of the token endpoint is not verified, e.g. for development environments
+// with a self-signed certificate.
+func (provider ConfiguredProvider) tokenHTTPClient() *http.Client {
+	var transport http.RoundTripper = http.DefaultTransport
+	switch {
+	case provider.allowInsecureOIDC:
+		base := http.DefaultTransport.(*http.Transport).Clone()
+		base.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12, InsecureSkipVerify: true} //nolint:gosec // opted in with allow_insecure_oidc
+		transport = base
+		if provider.transport != nil {
+			transport = provider.transport.withBase(base)
+		}
+	case provider.transport != nil:
+		transport = provider.transport
+	}
+	return &http.Client{Transport: transport, Timeout: tokenRequestTimeout}
+}
+
+// withTokenSource returns request middleware that authenticates requests with a token of the source.
+func withTokenSource(source oauth2.TokenSource) func(*resty.Client, *resty.Request) error {
+	return func(_ *resty.Client, req *resty.Request) error {
+		token, err := source.Token()
+		if err != nil {
+			return fmt.Errorf("failed to fetch OIDC token: %w", err)
+		}
+		req.SetAuthToken(token.AccessToken)
+		return nil
+	}
+}
+
+// tokenExchangeSource exchanges a subject JWT, e.g. a CI or Kubernetes workload identity token, for an API access
+// token of a service account. The subject token file is read on every exchange, so rotated tokens are picked up.
+type tokenExchangeSource struct {
+	httpClient          *http.Client
+	tokenURL            string
+	subjectToken        string
+	subjectTokenFile    string
+	organisation        string
+	serviceAccountID    string
+	accessTokenLifetime string
+}
+
+func (s *tokenExchangeSource) Token() (*oauth2.Token, error) {
+	subjectToken, err := s.resolveSubjectToken()
+	if err != nil {
+		return nil, err
+	}
+	form := url.Values{}
+	form.Set("grant_type", oidcGrantTypeTokenExchange)
+	form.Set("subject_token", subjectToken)
+	form.Set("subject_token_type", oidcTokenTypeJWT)
+	form.Set("organisation_id", s.organisation)
+	form.Set("service_account_id", s.serviceAccountID)
+	if s.accessTokenLifetime != "" {
+		form.Set("access_token_lifetime", s.accessTokenLifetime)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("OIDC token exchange: build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("OIDC token exchange: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("OIDC token exchange: read body: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("OIDC token exchange: %s (body: %s)", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	var response struct {
+		AccessToken string `json:"access_token"`
+		TokenType   string `json:"token_type"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("OIDC token exchange: decode response: %w", err)
+	}
+	if response.AccessToken == "" {
+		return nil, fmt.Errorf("OIDC token exchange: empty access_token in response")
+	}
+
+	token := &oauth2.Token{AccessToken: response.AccessToken, TokenType: response.TokenType}
+	if response.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(response.ExpiresIn) * time.Second)
+	} else {
+		token.Expiry = time.Now().Add(time.Hour)
+	}
+	return token, nil
+}
+
+func (s *tokenExchangeSource) resolveSubjectToken() (string, error) {
+	if path := strings.TrimSpace(s.subjectTokenFile); path != "" {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("OIDC token exchange: read subject token file: %w", err)
+		}
+		token := strings.TrimSpace(string(b))
+		if token == "" {
+			return "", fmt.Errorf("OIDC token exchange: subject token file %q is empty", path)
+		}
+		return token, nil
+	}
+	if token := strings.TrimSpace(s.subjectToken); token != "" {
+		return token, nil
+	}
+	return "", fmt.Errorf("OIDC token exchange: no subject token configured")
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/thalassa-cloud/client-go/thalassa"
+)
+
+// frameworkBase holds the configured provider of plugin framework ephemeral resources, actions and list resources.
+type frameworkBase struct {
+	provider *ConfiguredProvider
+}
+
+func (b *frameworkBase) configure(providerData any, diags *fwdiag.Diagnostics) {
+	if providerData == nil {
+		return
+	}
+	p, ok := providerData.(ConfiguredProvider)
+	if !ok {
+		diags.AddError("Unexpected provider data", fmt.Sprintf("Expected ConfiguredProvider, got %T.", providerData))
+		return
+	}
+	b.provider = &p
+}
+
+// Client returns a client for the given organisation and project, falling back to the provider defaults.
+func (b *frameworkBase) Client(organisation, project types.String) (thalassa.Client, fwdiag.Diagnostics) {
+	var diags fwdiag.Diagnostics
+	if b.provider == nil {
+		diags.AddError("Unconfigured provider", "The Thalassa Cloud provider has not been configured. Make sure the provider configuration is known before ephemeral resources are opened, actions are invoked or resources are listed.")
+		return nil, diags
+	}
+	client, err := GetScopedClient(*b.provider, organisation.ValueString(), project.ValueString())
+	if err != nil {
+		diags.AddError("Failed to create client", err.Error())
+		return nil, diags
+	}
+	return client, diags
+}
+
+// EphemeralResourceBase is embedded by plugin framework ephemeral resources to receive the configured provider.
+type EphemeralResourceBase struct {
+	frameworkBase
+}
+
+func (r *EphemeralResourceBase) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	r.configure(req.ProviderData, &resp.Diagnostics)
+}
+
+// ActionBase is embedded by plugin framework actions to receive the configured provider.
+type ActionBase struct {
+	frameworkBase
+}
+
+func (a *ActionBase) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.configure(req.ProviderData, &resp.Diagnostics)
+}
+
+// SendActionProgress reports the progress of an action invocation to Terraform.
+func SendActionProgress(resp *action.InvokeResponse, message string) {
+	if resp.SendProgress != nil {
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	}
+}
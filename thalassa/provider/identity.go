@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// WithIDIdentity adds a resource identity holding the resource ID to a managed resource. The identity is set after
+// every create, read and update, and can be used instead of the ID to import the resource, which is how
+// `terraform query` list results are imported.
+func WithIDIdentity(r *schema.Resource) *schema.Resource {
+	r.Identity = &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				"id": {
+					Type:              schema.TypeString,
+					RequiredForImport: true,
+					Description:       "The ID of the resource",
+				},
+			}
+		},
+	}
+	r.CreateContext = withIDIdentity(r.CreateContext)
+	r.ReadContext = withIDIdentity(r.ReadContext)
+	r.UpdateContext = withIDIdentity(r.UpdateContext)
+
+	if r.Importer != nil && r.Importer.StateContext != nil {
+		importState := r.Importer.StateContext
+		r.Importer.StateContext = func(ctx context.Context, d *schema.ResourceData, m any) ([]*schema.ResourceData, error) {
+			if d.Id() == "" {
+				identity, err := d.Identity()
+				if err != nil {
+					return nil, err
+				}
+				d.SetId(identity.Get("id").(string))
+			}
+			return importState(ctx, d, m)
+		}
+	}
+	return r
+}
+
+func withIDIdentity[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](f F) F {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+		diags := f(ctx, d, m)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		return append(diags, SetIDIdentity(d)...)
+	}
+}
+
+// SetIDIdentity sets the resource identity of a resource with an ID identity to its ID.
+func SetIDIdentity(d *schema.ResourceData) diag.Diagnostics {
+	identity, err := d.Identity()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := identity.Set("id", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package provider_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+func TestWithIDIdentity(t *testing.T) {
+	t.Parallel()
+
+	r := provider.WithIDIdentity(&schema.Resource{
+		CreateContext: func(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+			d.SetId("vpc-1")
+			return nil
+		},
+		DeleteContext: func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+			return nil
+		},
+		ReadContext: func(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+			if d.Id() == "gone" {
+				d.SetId("")
+			}
+			return nil
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Optional: true, ForceNew: true},
+		},
+	})
+	assert.NoError(t, r.InternalValidate(nil, true))
+
+	d := r.Data(nil)
+	assert.False(t, r.CreateContext(context.Background(), d, nil).HasError())
+	identity, err := d.Identity()
+	assert.NoError(t, err)
+	assert.Equal(t, "vpc-1", identity.Get("id"))
+
+	d = r.Data(&terraform.InstanceState{ID: "vpc-1"})
+	assert.False(t, r.ReadContext(context.Background(), d, nil).HasError())
+	identity, err = d.Identity()
+	assert.NoError(t, err)
+	assert.Equal(t, "vpc-1", identity.Get("id"))
+
+	d = r.Data(&terraform.InstanceState{ID: "gone"})
+	assert.False(t, r.ReadContext(context.Background(), d, nil).HasError())
+	identity, err = d.Identity()
+	assert.NoError(t, err)
+	assert.Empty(t, identity.Get("id"))
+
+	// importing by identity leaves the ID empty until the importer sets it from the identity
+	d = r.Data(&terraform.InstanceState{Identity: map[string]string{"id": "vpc-2"}})
+	imported, err := r.Importer.StateContext(context.Background(), d, nil)
+	assert.NoError(t, err)
+	if assert.Len(t, imported, 1) {
+		assert.Equal(t, "vpc-2", imported[0].Id())
+	}
+}
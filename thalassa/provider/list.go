@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/thalassa-cloud/client-go/filters"
+	"github.com/thalassa-cloud/client-go/thalassa"
+)
+
+// ListedObject is an object enumerated by a list resource.
+type ListedObject struct {
+	ID   string
+	Name string
+}
+
+var (
+	_ list.ListResourceWithConfigure    = &SDKListResource{}
+	_ list.ListResourceWithRawV5Schemas = &SDKListResource{}
+)
+
+// SDKListResource is a plugin framework list resource for an SDKv2 managed resource with an ID identity (see
+// WithIDIdentity). Listed objects are read through the managed resource, so `terraform query` returns the same
+// state and configuration as importing each object by ID.
+type SDKListResource struct {
+	frameworkBase
+
+	// TypeName is the name of the managed resource without the provider prefix.
+	TypeName string
+	// Description describes the listed objects.
+	Description string
+	// Resource returns the managed resource.
+	Resource func() *schema.Resource
+	// Regional adds a region filter to the list configuration.
+	Regional bool
+	// ListObjects returns the objects matching the filters.
+	ListObjects func(ctx context.Context, client thalassa.Client, filters []filters.Filter) ([]ListedObject, error)
+}
+
+func (r *SDKListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.TypeName
+}
+
+func (r *SDKListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.configure(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *SDKListResource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, resp *list.RawV5SchemaResponse) {
+	res := r.Resource()
+	resp.ProtoV5Schema = res.ProtoSchema(ctx)()
+	resp.ProtoV5IdentitySchema = res.ProtoIdentitySchema(ctx)()
+}
+
+func (r *SDKListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	attributes := map[string]listschema.Attribute{
+		"labels": listschema.MapAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "Only list objects that have all of these labels.",
+		},
+	}
+	if r.Regional {
+		attributes["region"] = listschema.StringAttribute{
+			Optional:    true,
+			Description: "Only list objects in this region. Provide the identity or slug of the region.",
+		}
+	}
+	resp.Schema = listschema.Schema{
+		Description: fmt.Sprintf("List existing %s with `terraform query`", r.Description),
+		Attributes:  attributes,
+	}
+}
+
+func (r *SDKListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	requestFilters, diags := r.filters(ctx, req.Config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	client, diags := r.Client(types.StringNull(), types.StringNull())
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	objects, err := r.ListObjects(ctx, client, requestFilters)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Failed to list %s", r.Description), err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	res := r.Resource()
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, object := range objects {
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			result, ok := r.listResult(ctx, req, res, object)
+			if !ok {
+				continue
+			}
+			if !push(result) {
+				return
+			}
+			count++
+		}
+	}
+}
+
+func (r *SDKListResource) filters(ctx context.Context, config tfsdk.Config) ([]filters.Filter, fwdiag.Diagnostics) {
+	var diags fwdiag.Diagnostics
+	requestFilters := []filters.Filter{}
+
+	if r.Regional {
+		var region types.String
+		diags.Append(config.GetAttribute(ctx, path.Root("region"), &region)...)
+		if region.ValueString() != "" {
+			requestFilters = append(requestFilters, &filters.FilterKeyValue{
+				Key:   filters.FilterRegion,
+				Value: region.ValueString(),
+			})
+		}
+	}
+
+	var labels map[string]string
+	diags.Append(config.GetAttribute(ctx, path.Root("labels"), &labels)...)
+	if len(labels) > 0 {
+		requestFilters = append(requestFilters, &filters.LabelFilter{
+			MatchLabels: labels,
+		})
+	}
+	return requestFilters, diags
+}
+
+// listResult returns the list result of an object, reading it through the managed resource when the resource is
+// requested. It returns false if the object no longer exists.
+func (r *SDKListResource) listResult(ctx context.Context, req list.ListRequest, res *schema.Resource, object ListedObject) (list.ListResult, bool) {
+	result := req.NewListResult(ctx)
+	result.DisplayName = object.Name
+
+	d := res.Data(&terraform.InstanceState{
+		ID:         object.ID,
+		Attributes: map[string]string{"id": object.ID},
+	})
+	if req.IncludeResource {
+		result.Diagnostics.Append(frameworkDiagnostics(res.ReadContext(ctx, d, *r.provider))...)
+		if result.Diagnostics.HasError() {
+			return result, true
+		}
+		if d.Id() == "" {
+			return result, false
+		}
+	}
+
+	result.Diagnostics.Append(frameworkDiagnostics(SetIDIdentity(d))...)
+	if result.Diagnostics.HasError() {
+		return result, true
+	}
+	identity, err := d.TfTypeIdentityState()
+	if err != nil {
+		result.Diagnostics.AddError("Failed to convert resource identity", err.Error())
+		return result, true
+	}
+	result.Identity.Raw = *identity
+
+	if req.IncludeResource {
+		state, err := d.TfTypeResourceState()
+		if err != nil {
+			result.Diagnostics.AddError("Failed to convert resource state", err.Error())
+			return result, true
+		}
+		result.Resource.Raw = *state
+	}
+	return result, true
+}
+
+// frameworkDiagnostics converts SDKv2 diagnostics to plugin framework diagnostics.
+func frameworkDiagnostics(diags diag.Diagnostics) fwdiag.Diagnostics {
+	var result fwdiag.Diagnostics
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			result.AddError(d.Summary, d.Detail)
+		} else {
+			result.AddWarning(d.Summary, d.Detail)
+		}
+	}
+	return result
+}
+
+// ListedObjects converts the items returned by a client list call to listed objects.
+func ListedObjects[T any](items []T, object func(T) ListedObject) []ListedObject {
+	objects := make([]ListedObject, 0, len(items))
+	for _, item := range items {
+		objects = append(objects, object(item))
+	}
+	return objects
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
+	"time"
 
+	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -21,73 +24,84 @@ func GetProvider(m any) ConfiguredProvider {
 }
 
 type ConfiguredProvider struct {
-	Client            thalassa.Client
-	Organisation      string
-	token             string
-	accessToken       string
-	apiEndpoint       string
-	clientID          string
-	clientSecret      string
-	allowInsecureOIDC bool
-	projectID         string
+	Client              thalassa.Client
+	Organisation        string
+	token               string
+	accessToken         string
+	apiEndpoint         string
+	clientID            string
+	clientSecret        string
+	allowInsecureOIDC   bool
+	projectID           string
+	oidcToken           string
+	oidcTokenFile       string
+	serviceAccountID    string
+	accessTokenLifetime string
+	quotaLedger         *quotaLedger
+	transport           *transport
+	clients             *clientCache
+	tokens              *tokenSources
 }
 
 func ProviderConfigure(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
-	token := d.Get("token").(string)
-	accessToken := d.Get("access_token").(string)
-	apiEndpoint := d.Get("api").(string)
-	organisation := d.Get("organisation_id").(string)
-	clientID := d.Get("client_id").(string)
-	clientSecret := d.Get("client_secret").(string)
-	allowInsecureOIDC := d.Get("allow_insecure_oidc").(bool)
-	projectID := d.Get("project_id").(string)
-
-	opts := []client.Option{
-		client.WithBaseURL(apiEndpoint),
-		client.WithOrganisation(organisation),
-		client.WithUserAgent("thalassa-cloud/terraform-provider-thalassa"),
+	provider := ConfiguredProvider{
+		Organisation:      d.Get("organisation_id").(string),
+		token:             d.Get("token").(string),
+		accessToken:       d.Get("access_token").(string),
+		apiEndpoint:       d.Get("api").(string),
+		clientID:          d.Get("client_id").(string),
+		clientSecret:      d.Get("client_secret").(string),
+		allowInsecureOIDC: d.Get("allow_insecure_oidc").(bool),
+		projectID:         d.Get("project_id").(string),
+		oidcToken:         d.Get("oidc_token").(string),
+		oidcTokenFile:     d.Get("oidc_token_file").(string),
+		serviceAccountID:  d.Get("service_account_id").(string),
+	}
+
+	if d.Get("quota_preflight").(bool) {
+		provider.quotaLedger = newQuotaLedger()
+	}
+
+	if lifetime := d.Get("access_token_lifetime").(string); lifetime != "" {
+		duration, err := time.ParseDuration(lifetime)
+		if err != nil || duration <= 0 {
+			return nil, diag.FromErr(fmt.Errorf("invalid access_token_lifetime %q: must be a positive duration such as 1h", lifetime))
+		}
+		provider.accessTokenLifetime = fmt.Sprintf("%ds", int64(duration.Seconds()))
 	}
 
-	hasAuth := false
-	if token != "" {
-		opts = append(opts, client.WithAuthPersonalToken(token))
-		hasAuth = true
+	settings := transportSettings{
+		maxRetries:        d.Get("max_retries").(int),
+		requestsPerSecond: d.Get("requests_per_second").(float64),
 	}
-
-	if accessToken != "" {
-		opts = append(opts, client.WithToken(accessToken))
-		hasAuth = true
+	if settings.maxRetries < 0 {
+		return nil, diag.FromErr(fmt.Errorf("invalid max_retries %d: must not be negative", settings.maxRetries))
 	}
-
-	if clientID != "" && clientSecret != "" {
-		opts = append(opts, client.WithAuthOIDCInsecure(clientID, clientSecret, fmt.Sprintf("%s/oidc/token", apiEndpoint), allowInsecureOIDC))
-		hasAuth = true
+	if settings.requestsPerSecond < 0 {
+		return nil, diag.FromErr(fmt.Errorf("invalid requests_per_second %v: must not be negative", settings.requestsPerSecond))
 	}
-
-	if projectID != "" {
-		opts = append(opts, client.WithProject(projectID))
+	if maxWait := d.Get("retry_max_wait").(string); maxWait != "" {
+		duration, err := time.ParseDuration(maxWait)
+		if err != nil || duration < 0 {
+			return nil, diag.FromErr(fmt.Errorf("invalid retry_max_wait %q: must be a duration such as 30s", maxWait))
+		}
+		settings.retryMaxWait = duration
 	}
+	provider.transport = sharedTransport(settings)
 
-	if !hasAuth {
-		return nil, diag.FromErr(errors.New("no authentication method provided"))
+	if (provider.oidcToken != "" || provider.oidcTokenFile != "") && provider.serviceAccountID == "" {
+		return nil, diag.FromErr(errors.New("service_account_id is required when authenticating with an OIDC token"))
 	}
 
-	internalClient, err := thalassa.NewClient(opts...)
+	provider.tokens = provider.newTokenSources()
+	provider.clients = newClientCache()
+	internalClient, err := provider.client(provider.Organisation, provider.projectID)
 	if err != nil {
 		return nil, diag.FromErr(err)
 	}
+	provider.Client = internalClient
 
-	return ConfiguredProvider{
-		Client:            internalClient,
-		Organisation:      organisation,
-		token:             token,
-		accessToken:       accessToken,
-		apiEndpoint:       apiEndpoint,
-		clientID:          clientID,
-		clientSecret:      clientSecret,
-		allowInsecureOIDC: allowInsecureOIDC,
-		projectID:         projectID,
-	}, nil
+	return provider, nil
 }
 
 func GetClient(provider ConfiguredProvider, d *schema.ResourceData) (thalassa.Client, error) {
@@ -95,11 +109,36 @@ func GetClient(provider ConfiguredProvider, d *schema.ResourceData) (thalassa.Cl
 	if err != nil {
 		return nil, err
 	}
+	return provider.client(organisation, getProject(provider, d))
+}
 
+// GetScope returns the organisation and project of a resource: its organisation_id and project_id when set, falling
+// back to the provider organisation and project.
+func GetScope(provider ConfiguredProvider, d ConfigGetter) (organisation, project string, err error) {
+	organisation, err = getOrganisation(provider, d)
+	if err != nil {
+		return "", "", err
+	}
+	return organisation, getProject(provider, d), nil
+}
+
+// GetScopedClient returns a client for the given organisation and project, falling back to the
+// provider organisation and project when they are empty.
+func GetScopedClient(provider ConfiguredProvider, organisation, project string) (thalassa.Client, error) {
+	config := scope{"organisation_id": organisation, "project_id": project}
+	organisation, err := getOrganisation(provider, config)
+	if err != nil {
+		return nil, err
+	}
+	return provider.client(organisation, getProject(provider, config))
+}
+
+// newClient creates a client for the given organisation and project using the configured authentication method.
+func (provider ConfiguredProvider) newClient(organisation, project string) (thalassa.Client, error) {
 	opts := []client.Option{
 		client.WithBaseURL(provider.apiEndpoint),
 		client.WithOrganisation(organisation),
-		client.WithUserAgent("thalassa-cloud/terraform-provider-thalassa"),
+		client.WithUserAgent(userAgent),
 	}
 
 	hasAuth := false
@@ -113,27 +152,49 @@ func GetClient(provider ConfiguredProvider, d *schema.ResourceData) (thalassa.Cl
 		hasAuth = true
 	}
 
-	if provider.clientID != "" && provider.clientSecret != "" {
-		opts = append(opts, client.WithAuthOIDCInsecure(provider.clientID, provider.clientSecret, fmt.Sprintf("%s/oidc/token", provider.apiEndpoint), provider.allowInsecureOIDC))
+	// OIDC tokens are shared by the clients of all organisations and projects, or of an organisation for exchanged
+	// tokens, and refreshed before they expire.
+	if provider.tokens != nil {
+		if provider.tokens.perOrganisation && organisation == "" {
+			return nil, errors.New("organisation_id is required when authenticating with an OIDC token")
+		}
+		opts = append(opts, client.WithAuthCustom(), client.WithMiddleware(withTokenSource(provider.tokens.get(organisation))))
 		hasAuth = true
 	}
 
-	if provider.projectID != "" {
-		opts = append(opts, client.WithProject(provider.projectID))
+	if project != "" {
+		opts = append(opts, client.WithProject(project))
+	}
+
+	if provider.transport != nil {
+		// The client exposes its HTTP client only to request middleware. The transport is installed before the first
+		// request is sent, and the once guarantees concurrent requests only start after it was installed.
+		var once sync.Once
+		opts = append(opts, client.WithMiddleware(func(c *resty.Client, _ *resty.Request) error {
+			once.Do(func() { c.SetTransport(provider.transport) })
+			return nil
+		}))
 	}
 
 	if !hasAuth {
 		return nil, errors.New("no authentication method provided")
 	}
 
-	client, err := thalassa.NewClient(opts...)
-	if err != nil {
-		return nil, err
-	}
-	return client, nil
+	return thalassa.NewClient(opts...)
+}
+
+// ConfigGetter is implemented by schema.ResourceData and schema.ResourceDiff.
+type ConfigGetter interface {
+	Get(key string) any
 }
 
-func getOrganisation(provider ConfiguredProvider, d *schema.ResourceData) (string, error) {
+type scope map[string]any
+
+func (s scope) Get(key string) any {
+	return s[key]
+}
+
+func getOrganisation(provider ConfiguredProvider, d ConfigGetter) (string, error) {
 	organisation := provider.Organisation
 	orgFromState := d.Get("organisation_id")
 	if orgFromState != nil {
@@ -149,3 +210,11 @@ func getOrganisation(provider ConfiguredProvider, d *schema.ResourceData) (strin
 	}
 	return "", errors.New("organisation is not set")
 }
+
+// getProject returns the project_id of the resource when set, falling back to the provider project.
+func getProject(provider ConfiguredProvider, d ConfigGetter) string {
+	if projectFromState, ok := d.Get("project_id").(string); ok && projectFromState != "" {
+		return projectFromState
+	}
+	return provider.projectID
+}
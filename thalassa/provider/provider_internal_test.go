@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/thalassa-cloud/client-go/quotas"
+)
+
+func TestGetProjectPrefersResourceProject(t *testing.T) {
+	t.Parallel()
+
+	resourceSchema := map[string]*schema.Schema{
+		"organisation_id": {Type: schema.TypeString, Optional: true},
+		"project_id":      {Type: schema.TypeString, Optional: true},
+	}
+	configured := ConfiguredProvider{Organisation: "org-test", projectID: "prj-provider"}
+
+	withOverride := schema.TestResourceDataRaw(t, resourceSchema, map[string]any{"project_id": "prj-resource"})
+	assert.Equal(t, "prj-resource", getProject(configured, withOverride))
+
+	withoutOverride := schema.TestResourceDataRaw(t, resourceSchema, map[string]any{})
+	assert.Equal(t, "prj-provider", getProject(configured, withoutOverride))
+
+	withoutAttribute := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"organisation_id": {Type: schema.TypeString, Optional: true},
+	}, map[string]any{})
+	assert.Equal(t, "prj-provider", getProject(configured, withoutAttribute))
+}
+
+func TestCheckQuotaDemand(t *testing.T) {
+	t.Parallel()
+
+	organisationQuotas := map[string]quotas.OrganisationQuota{
+		QuotaBlockVolumes:   {Name: QuotaBlockVolumes, MaxUsage: 10, CurrentUsage: 8},
+		QuotaBlockStorageGB: {Name: QuotaBlockStorageGB, MaxUsage: 500, CurrentUsage: 400},
+	}
+
+	tests := []struct {
+		name    string
+		planned map[string]QuotaDemand
+		demand  QuotaDemand
+		wantErr string
+	}{
+		{
+			name:    "within quota",
+			planned: map[string]QuotaDemand{"a": {QuotaBlockVolumes: 1, QuotaBlockStorageGB: 50}},
+			demand:  QuotaDemand{QuotaBlockVolumes: 1, QuotaBlockStorageGB: 50},
+		},
+		{
+			name: "sum of planned creates exceeds quota",
+			planned: map[string]QuotaDemand{
+				"a": {QuotaBlockVolumes: 1, QuotaBlockStorageGB: 60},
+				"b": {QuotaBlockVolumes: 1, QuotaBlockStorageGB: 60},
+			},
+			demand:  QuotaDemand{QuotaBlockVolumes: 1, QuotaBlockStorageGB: 60},
+			wantErr: "block_storage_gb: 120 planned, 100 of 500 remaining",
+		},
+		{
+			name:    "quotas unknown to the API are not checked",
+			planned: map[string]QuotaDemand{"a": {QuotaReservedIPs: 100}},
+			demand:  QuotaDemand{QuotaReservedIPs: 100},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkQuotaDemand(organisationQuotas, tt.planned, tt.demand)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestQuotaLedgerCountsEveryPlannedCreate(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"name":"reserved_ips","maxUsage":3,"currentUsage":1}]`))
+	}))
+	defer server.Close()
+
+	configured := ConfiguredProvider{apiEndpoint: server.URL, accessToken: "test-token"}
+	client, err := configured.newClient("org-test", "")
+	assert.NoError(t, err)
+
+	ledger := newQuotaLedger()
+	demand := QuotaDemand{QuotaReservedIPs: 1}
+	ctx := context.Background()
+
+	// reserved IPs with the same name, in the same or another project, are all counted
+	assert.NoError(t, ledger.plan(ctx, client, "org-test", "prj-a", "thalassa_reserved_ip", demand))
+	assert.NoError(t, ledger.plan(ctx, client, "org-test", "prj-b", "thalassa_reserved_ip", demand))
+	assert.Error(t, ledger.plan(ctx, client, "org-test", "prj-a", "thalassa_reserved_ip", demand))
+}
+
+func TestQuotaLedgerSkipsUnreportedQuotas(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"name":"reserved_ips","maxUsage":3,"currentUsage":1}]`))
+	}))
+	defer server.Close()
+
+	configured := ConfiguredProvider{apiEndpoint: server.URL, accessToken: "test-token"}
+	client, err := configured.newClient("org-test", "")
+	assert.NoError(t, err)
+
+	ledger := newQuotaLedger()
+	demand := QuotaDemand{QuotaReservedIPs: 1, QuotaVCPUs: 64}
+	ctx := context.Background()
+
+	// vcpus is not reported by the organisation, so it is recorded as unknown and not checked
+	assert.NoError(t, ledger.plan(ctx, client, "org-test", "prj-a", "thalassa_virtual_machine_instance", demand))
+	assert.NoError(t, ledger.plan(ctx, client, "org-test", "prj-a", "thalassa_virtual_machine_instance", demand))
+	assert.Equal(t, map[string]map[string]bool{"org-test": {QuotaVCPUs: true}}, ledger.unknown)
+}
+
+func TestTokenHTTPClientAllowsInsecureOIDC(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/oidc/token", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"access-1","token_type":"Bearer","expires_in":3600}`))
+	}))
+	defer server.Close()
+
+	configured := ConfiguredProvider{
+		apiEndpoint:  server.URL,
+		clientID:     "client-id",
+		clientSecret: "client-secret",
+		transport:    sharedTransport(transportSettings{maxRetries: DefaultMaxRetries, retryMaxWait: DefaultRetryMaxWait}),
+	}
+
+	// the self-signed certificate of the server is rejected unless allow_insecure_oidc is set
+	_, err := configured.newTokenSources().get("").Token()
+	assert.ErrorContains(t, err, "certificate")
+
+	configured.allowInsecureOIDC = true
+	token, err := configured.newTokenSources().get("").Token()
+	assert.NoError(t, err)
+	assert.Equal(t, "access-1", token.AccessToken)
+}
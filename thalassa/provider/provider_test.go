@@ -2,6 +2,13 @@ package provider_test
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -56,3 +63,221 @@ func TestProviderConfigurePreservesAllowInsecureOIDC(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, client)
 }
+
+func TestProviderConfigureRequiresServiceAccountForOIDCToken(t *testing.T) {
+	t.Parallel()
+
+	p := thalassa.Provider()
+	rd := schema.TestResourceDataRaw(t, p.Schema, map[string]any{
+		"oidc_token":      "subject-jwt",
+		"api":             "https://api.thalassa.cloud",
+		"organisation_id": "org-test",
+	})
+
+	_, diags := provider.ProviderConfigure(context.Background(), rd)
+	assert.True(t, diags.HasError())
+}
+
+func TestProviderConfigureRejectsInvalidAccessTokenLifetime(t *testing.T) {
+	t.Parallel()
+
+	p := thalassa.Provider()
+	rd := schema.TestResourceDataRaw(t, p.Schema, map[string]any{
+		"oidc_token":            "subject-jwt",
+		"service_account_id":    "sa-test",
+		"access_token_lifetime": "forever",
+		"api":                   "https://api.thalassa.cloud",
+		"organisation_id":       "org-test",
+	})
+
+	_, diags := provider.ProviderConfigure(context.Background(), rd)
+	assert.True(t, diags.HasError())
+}
+
+func TestProviderOIDCTokenExchangeRefreshesFromFile(t *testing.T) {
+	t.Parallel()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	assert.NoError(t, os.WriteFile(tokenFile, []byte("subject-jwt-1"), 0o600))
+
+	var mu sync.Mutex
+	var subjects []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oidc/token" {
+			assert.NoError(t, r.ParseForm())
+			assert.Equal(t, "urn:ietf:params:oauth:grant-type:token-exchange", r.PostForm.Get("grant_type"))
+			assert.Equal(t, "sa-test", r.PostForm.Get("service_account_id"))
+			assert.Equal(t, "org-test", r.PostForm.Get("organisation_id"))
+			assert.Equal(t, "3600s", r.PostForm.Get("access_token_lifetime"))
+			mu.Lock()
+			subjects = append(subjects, r.PostForm.Get("subject_token"))
+			n := len(subjects)
+			mu.Unlock()
+			w.Header().Set("Content-Type", "application/json")
+			// tokens this close to expiry are exchanged again on the next request
+			_, _ = fmt.Fprintf(w, `{"access_token":"access-%d","token_type":"Bearer","expires_in":1}`, n)
+			return
+		}
+		assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "Bearer access-"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	p := thalassa.Provider()
+	rd := schema.TestResourceDataRaw(t, p.Schema, map[string]any{
+		"oidc_token_file":       tokenFile,
+		"service_account_id":    "sa-test",
+		"access_token_lifetime": "1h",
+		"api":                   server.URL,
+		"organisation_id":       "org-test",
+	})
+
+	configured, diags := provider.ProviderConfigure(context.Background(), rd)
+	assert.Empty(t, diags)
+
+	resourceData := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"organisation_id": {Type: schema.TypeString, Optional: true},
+	}, map[string]any{})
+
+	client, err := provider.GetClient(provider.GetProvider(configured), resourceData)
+	assert.NoError(t, err)
+
+	_, err = client.IAM().ListOrganisationRoles(context.Background(), nil)
+	assert.NoError(t, err)
+
+	// rotate the subject token, the next exchange must pick it up
+	assert.NoError(t, os.WriteFile(tokenFile, []byte("subject-jwt-2"), 0o600))
+
+	_, err = client.IAM().ListOrganisationRoles(context.Background(), nil)
+	assert.NoError(t, err)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{"subject-jwt-1", "subject-jwt-2"}, subjects)
+}
+
+func TestProviderConfigureRejectsInvalidRetryMaxWait(t *testing.T) {
+	t.Parallel()
+
+	p := thalassa.Provider()
+	rd := schema.TestResourceDataRaw(t, p.Schema, map[string]any{
+		"access_token":    "test-access-token",
+		"retry_max_wait":  "a while",
+		"api":             "https://api.thalassa.cloud",
+		"organisation_id": "org-test",
+	})
+
+	_, diags := provider.ProviderConfigure(context.Background(), rd)
+	assert.True(t, diags.HasError())
+}
+
+func TestProviderClientRetriesRateLimitedRequests(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls++
+		n := calls
+		mu.Unlock()
+		if n == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	p := thalassa.Provider()
+	rd := schema.TestResourceDataRaw(t, p.Schema, map[string]any{
+		"access_token":    "test-access-token",
+		"max_retries":     1,
+		"retry_max_wait":  "10ms",
+		"api":             server.URL,
+		"organisation_id": "org-test",
+	})
+
+	configured, diags := provider.ProviderConfigure(context.Background(), rd)
+	assert.Empty(t, diags)
+
+	client, err := provider.GetScopedClient(provider.GetProvider(configured), "", "")
+	assert.NoError(t, err)
+
+	_, err = client.IAM().ListOrganisationRoles(context.Background(), nil)
+	assert.NoError(t, err)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 2, calls)
+}
+
+func TestProviderCachesClientsPerOrganisationAndProject(t *testing.T) {
+	t.Parallel()
+
+	p := thalassa.Provider()
+	rd := schema.TestResourceDataRaw(t, p.Schema, map[string]any{
+		"access_token":    "test-access-token",
+		"api":             "https://api.thalassa.cloud",
+		"organisation_id": "org-test",
+	})
+
+	configured, diags := provider.ProviderConfigure(context.Background(), rd)
+	assert.Empty(t, diags)
+	configuredProvider := provider.GetProvider(configured)
+
+	first, err := provider.GetScopedClient(configuredProvider, "", "project-a")
+	assert.NoError(t, err)
+	second, err := provider.GetScopedClient(configuredProvider, "org-test", "project-a")
+	assert.NoError(t, err)
+	other, err := provider.GetScopedClient(configuredProvider, "org-test", "project-b")
+	assert.NoError(t, err)
+
+	assert.Same(t, first, second)
+	assert.NotSame(t, first, other)
+}
+
+func TestProviderSharesClientCredentialsToken(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	tokenRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/oidc/token" {
+			mu.Lock()
+			tokenRequests++
+			mu.Unlock()
+			_, _ = w.Write([]byte(`{"access_token":"access-1","token_type":"Bearer","expires_in":3600}`))
+			return
+		}
+		assert.Equal(t, "Bearer access-1", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	p := thalassa.Provider()
+	rd := schema.TestResourceDataRaw(t, p.Schema, map[string]any{
+		"client_id":       "client-id",
+		"client_secret":   "client-secret",
+		"api":             server.URL,
+		"organisation_id": "org-test",
+	})
+
+	configured, diags := provider.ProviderConfigure(context.Background(), rd)
+	assert.Empty(t, diags)
+
+	for _, scope := range [][2]string{{"", ""}, {"org-test", "project-a"}, {"org-other", "project-b"}} {
+		client, err := provider.GetScopedClient(provider.GetProvider(configured), scope[0], scope[1])
+		assert.NoError(t, err)
+		_, err = client.IAM().ListOrganisationRoles(context.Background(), nil)
+		assert.NoError(t, err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 1, tokenRequests)
+}
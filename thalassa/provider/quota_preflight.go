@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/thalassa-cloud/client-go/iaas"
+	"github.com/thalassa-cloud/client-go/quotas"
+	"github.com/thalassa-cloud/client-go/thalassa"
+)
+
+// Organisation quotas checked by quota_preflight. client-go only exposes quota names as the Name of
+// quotas.OrganisationQuota, so these must match the names the quotas API reports.
+const (
+	QuotaVirtualMachines = "virtual_machines"
+	QuotaVCPUs           = "vcpus"
+	QuotaMemoryGB        = "memory_gb"
+	QuotaBlockVolumes    = "block_volumes"
+	QuotaBlockStorageGB  = "block_storage_gb"
+	QuotaReservedIPs     = "reserved_ips"
+)
+
+// QuotaDemand is the amount of each organisation quota a resource consumes, keyed by quota name.
+type QuotaDemand map[string]int64
+
+// QuotaDemandFunc returns the quota the planned resource consumes when created.
+type QuotaDemandFunc func(ctx context.Context, d *schema.ResourceDiff, client thalassa.Client) (QuotaDemand, error)
+
+// quotaLedger tracks the quota usage of the organisations and the demand of all resources planned for creation.
+//
+// Providers are not told the address of the resource they plan, and names are neither unique nor always known at
+// plan time, so every planned creation is its own entry. Terraform plans a resource once per graph walk and starts
+// new provider processes for every walk, so no resource is counted twice.
+type quotaLedger struct {
+	mu      sync.Mutex
+	quotas  map[string]map[string]quotas.OrganisationQuota
+	planned map[string]map[string]QuotaDemand
+	unknown map[string]map[string]bool
+	entries int
+}
+
+func newQuotaLedger() *quotaLedger {
+	return &quotaLedger{
+		quotas:  map[string]map[string]quotas.OrganisationQuota{},
+		planned: map[string]map[string]QuotaDemand{},
+		unknown: map[string]map[string]bool{},
+	}
+}
+
+// QuotaPreflight returns a CustomizeDiff function that fails the plan when the resources planned for creation
+// would exceed the remaining organisation quota. It does nothing unless quota_preflight is enabled.
+func QuotaPreflight(resourceType string, demand QuotaDemandFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m any) error {
+		provider, ok := m.(ConfiguredProvider)
+		if !ok || provider.quotaLedger == nil || d.Id() != "" {
+			return nil
+		}
+
+		organisation, err := getOrganisation(provider, d)
+		if err != nil {
+			return err
+		}
+		project := getProject(provider, d)
+		client, err := provider.client(organisation, project)
+		if err != nil {
+			return err
+		}
+
+		resourceDemand, err := demand(ctx, d, client)
+		if err != nil {
+			return fmt.Errorf("quota preflight for %s: %w", resourceType, err)
+		}
+		if len(resourceDemand) == 0 {
+			return nil
+		}
+		return provider.quotaLedger.plan(ctx, client, organisation, project, resourceType, resourceDemand)
+	}
+}
+
+// plan adds the demand of a resource planned for creation in the project and checks the combined demand of the
+// organisation against its quota.
+func (l *quotaLedger) plan(ctx context.Context, client thalassa.Client, organisation, project, resourceType string, demand QuotaDemand) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	organisationQuotas, ok := l.quotas[organisation]
+	if !ok {
+		list, err := client.Quotas().ListOrganisationQuotas(ctx)
+		if err != nil {
+			tflog.Warn(ctx, "Skipping quota preflight, organisation quotas could not be read", map[string]any{
+				"organisation": organisation,
+				"error":        err.Error(),
+			})
+			return nil
+		}
+		organisationQuotas = make(map[string]quotas.OrganisationQuota, len(list))
+		for _, quota := range list {
+			organisationQuotas[quota.Name] = quota
+		}
+		l.quotas[organisation] = organisationQuotas
+	}
+
+	l.warnUnknownQuotas(ctx, organisation, organisationQuotas, demand)
+
+	if l.planned[organisation] == nil {
+		l.planned[organisation] = map[string]QuotaDemand{}
+	}
+	l.entries++
+	l.planned[organisation][fmt.Sprintf("%s/%s/%d", project, resourceType, l.entries)] = demand
+
+	return checkQuotaDemand(organisationQuotas, l.planned[organisation], demand)
+}
+
+// warnUnknownQuotas logs a warning, once per organisation, for every quota of demand that the API does not report.
+// These quotas are not checked, which usually means the quota was renamed or is not enforced for the organisation.
+func (l *quotaLedger) warnUnknownQuotas(ctx context.Context, organisation string, organisationQuotas map[string]quotas.OrganisationQuota, demand QuotaDemand) {
+	for name := range demand {
+		if _, ok := organisationQuotas[name]; ok || l.unknown[organisation][name] {
+			continue
+		}
+		if l.unknown[organisation] == nil {
+			l.unknown[organisation] = map[string]bool{}
+		}
+		l.unknown[organisation][name] = true
+		tflog.Warn(ctx, "Skipping quota preflight for a quota the organisation does not report", map[string]any{
+			"organisation": organisation,
+			"quota":        name,
+		})
+	}
+}
+
+// checkQuotaDemand returns an error for every quota of demand that the combined planned demand exceeds.
+// Quotas that are not reported by the API are not checked.
+func checkQuotaDemand(organisationQuotas map[string]quotas.OrganisationQuota, planned map[string]QuotaDemand, demand QuotaDemand) error {
+	names := make([]string, 0, len(demand))
+	for name := range demand {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var exceeded []string
+	for _, name := range names {
+		quota, ok := organisationQuotas[name]
+		if !ok {
+			continue
+		}
+		var total int64
+		for _, d := range planned {
+			total += d[name]
+		}
+		remaining := quota.MaxUsage - quota.CurrentUsage
+		if total > remaining {
+			exceeded = append(exceeded, fmt.Sprintf("%s: %d planned, %d of %d remaining", name, total, max(remaining, 0), quota.MaxUsage))
+		}
+	}
+	if len(exceeded) == 0 {
+		return nil
+	}
+	return fmt.Errorf("planned resources exceed the organisation quota (%s); request a quota increase or disable quota_preflight", strings.Join(exceeded, "; "))
+}
+
+// MachineQuotaDemand returns the quota consumed by count machines of the given machine type,
+// which may be referenced by identity, slug or name.
+func MachineQuotaDemand(ctx context.Context, client thalassa.Client, machineType string, count int64) (QuotaDemand, error) {
+	demand := QuotaDemand{QuotaVirtualMachines: count}
+	if machineType == "" || count == 0 {
+		return demand, nil
+	}
+
+	machineTypes, err := client.IaaS().ListMachineTypes(ctx, &iaas.ListMachineTypesRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("listing machine types: %w", err)
+	}
+	for _, mt := range machineTypes {
+		if mt.Identity == machineType || mt.Slug == machineType || mt.Name == machineType {
+			demand[QuotaVCPUs] = int64(mt.Vcpus) * count
+			demand[QuotaMemoryGB] = int64(mt.RamMb) / 1024 * count
+			return demand, nil
+		}
+	}
+	return demand, nil
+}
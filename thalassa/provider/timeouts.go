@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DeprecatedTimeoutMessage returns the deprecation message of a `*_timeout` argument that has been replaced by the
+// given operation of the `timeouts` block.
+func DeprecatedTimeoutMessage(operation string) string {
+	return fmt.Sprintf("Use the %s value of the timeouts block instead.", operation)
+}
+
+// Timeout returns the timeout of an operation (schema.TimeoutCreate, schema.TimeoutDelete, ...). While a deprecated
+// timeout argument in minutes is set to something other than its default it takes precedence over the `timeouts`
+// block, so existing configurations keep their behaviour.
+func Timeout(d *schema.ResourceData, key, deprecatedAttribute string, deprecatedDefault int) time.Duration {
+	if minutes, ok := d.Get(deprecatedAttribute).(int); ok && minutes != deprecatedDefault {
+		return time.Duration(minutes) * time.Minute
+	}
+	return d.Timeout(key)
+}
@@ -0,0 +1,54 @@
+package provider_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+func TestTimeout(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		"wait_timeout": {
+			Type:       schema.TypeInt,
+			Optional:   true,
+			Default:    5,
+			Deprecated: provider.DeprecatedTimeoutMessage("create"),
+		},
+	}
+
+	tests := []struct {
+		name     string
+		config   map[string]any
+		expected time.Duration
+	}{
+		{
+			name:     "deprecated argument unset uses the operation timeout",
+			config:   map[string]any{},
+			expected: 20 * time.Minute,
+		},
+		{
+			name:     "deprecated argument at its default uses the operation timeout",
+			config:   map[string]any{"wait_timeout": 5},
+			expected: 20 * time.Minute,
+		},
+		{
+			name:     "deprecated argument takes precedence",
+			config:   map[string]any{"wait_timeout": 45},
+			expected: 45 * time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			d := schema.TestResourceDataRaw(t, s, tt.config)
+			assert.Equal(t, tt.expected, provider.Timeout(d, schema.TimeoutCreate, "wait_timeout", 5))
+		})
+	}
+}
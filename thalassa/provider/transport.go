@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"io"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DefaultMaxRetries is the default number of retries of an idempotent API request.
+	DefaultMaxRetries = 3
+	// DefaultRetryMaxWait is the default upper bound of the delay between retries.
+	DefaultRetryMaxWait = 30 * time.Second
+
+	retryMinWait = time.Second
+)
+
+// transportSettings configure the rate limiting and retries of API requests.
+type transportSettings struct {
+	maxRetries        int
+	retryMaxWait      time.Duration
+	requestsPerSecond float64
+}
+
+var (
+	transportsMu sync.Mutex
+	transports   = map[transportSettings]*transport{}
+)
+
+// sharedTransport returns the transport for the given settings. All clients with the same settings share the
+// transport, so the rate limit holds across all concurrent operations, including those of the plugin framework
+// provider, which is configured separately.
+func sharedTransport(settings transportSettings) *transport {
+	transportsMu.Lock()
+	defer transportsMu.Unlock()
+
+	if t, ok := transports[settings]; ok {
+		return t
+	}
+	t := newTransport(http.DefaultTransport.(*http.Transport).Clone(), settings)
+	transports[settings] = t
+	return t
+}
+
+// transport limits the rate of API requests and retries idempotent requests that were rate limited (429) or failed
+// with a transient server or network error, honouring the Retry-After header of the response.
+type transport struct {
+	base     http.RoundTripper
+	settings transportSettings
+	limiter  *rate.Limiter
+}
+
+func newTransport(base http.RoundTripper, settings transportSettings) *transport {
+	t := &transport{base: base, settings: settings}
+	if settings.requestsPerSecond > 0 {
+		t.limiter = rate.NewLimiter(rate.Limit(settings.requestsPerSecond), max(1, int(math.Ceil(settings.requestsPerSecond))))
+	}
+	return t
+}
+
+// withBase returns a transport that sends requests with the base transport, sharing the rate limit of t.
+func (t *transport) withBase(base http.RoundTripper) *transport {
+	return &transport{base: base, settings: t.settings, limiter: t.limiter}
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	retryable := isIdempotent(req.Method) && (req.Body == nil || req.Body == http.NoBody || req.GetBody != nil)
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(ctx)
+			req.Body = body
+		}
+		if t.limiter != nil {
+			if err := t.limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := t.base.RoundTrip(req)
+		if !retryable || attempt >= t.settings.maxRetries || !shouldRetry(resp, err) || ctx.Err() != nil {
+			return resp, err
+		}
+
+		wait := t.retryWait(attempt, resp)
+		fields := map[string]any{
+			"method":  req.Method,
+			"url":     req.URL.String(),
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		}
+		if resp != nil {
+			fields["status_code"] = resp.StatusCode
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		} else {
+			fields["error"] = err.Error()
+		}
+		tflog.Debug(ctx, "retrying Thalassa Cloud API request", fields)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// retryWait returns the delay before the next attempt: the Retry-After of the response when present, otherwise an
+// exponential backoff with jitter. Both are capped at the maximum wait.
+func (t *transport) retryWait(attempt int, resp *http.Response) time.Duration {
+	maxWait := t.settings.retryMaxWait
+	if resp != nil {
+		if wait, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(wait, maxWait)
+		}
+	}
+	backoff := min(retryMinWait<<min(attempt, 16), maxWait)
+	half := backoff / 2
+	return half + rand.N(half+1)
+}
+
+// retryAfter parses a Retry-After header value, which is either a number of seconds or an HTTP date.
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(0, time.Duration(seconds)*time.Second), true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(0, time.Until(date)), true
+	}
+	return 0, false
+}
+
+// shouldRetry reports whether the response is a rate limit, a transient server error or a network error. Internal
+// server errors are retried as well, since they are usually transient and only idempotent requests are retried.
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// isIdempotent reports whether requests with the method can safely be sent more than once.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
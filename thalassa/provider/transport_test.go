@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransportRetries(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		method        string
+		statuses      []int
+		expectedCalls int32
+		expectedCode  int
+	}{
+		{
+			name:          "rate limited GET is retried",
+			method:        http.MethodGet,
+			statuses:      []int{http.StatusTooManyRequests, http.StatusOK},
+			expectedCalls: 2,
+			expectedCode:  http.StatusOK,
+		},
+		{
+			name:          "transient server errors of DELETE are retried",
+			method:        http.MethodDelete,
+			statuses:      []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusNoContent},
+			expectedCalls: 3,
+			expectedCode:  http.StatusNoContent,
+		},
+		{
+			name:          "POST is not retried",
+			method:        http.MethodPost,
+			statuses:      []int{http.StatusTooManyRequests, http.StatusCreated},
+			expectedCalls: 1,
+			expectedCode:  http.StatusTooManyRequests,
+		},
+		{
+			name:          "internal server errors of PUT are retried",
+			method:        http.MethodPut,
+			statuses:      []int{http.StatusInternalServerError, http.StatusOK},
+			expectedCalls: 2,
+			expectedCode:  http.StatusOK,
+		},
+		{
+			name:          "not implemented is not retried",
+			method:        http.MethodGet,
+			statuses:      []int{http.StatusNotImplemented, http.StatusOK},
+			expectedCalls: 1,
+			expectedCode:  http.StatusNotImplemented,
+		},
+		{
+			name:          "gives up after the maximum number of retries",
+			method:        http.MethodGet,
+			statuses:      []int{http.StatusServiceUnavailable},
+			expectedCalls: 3,
+			expectedCode:  http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				call := calls.Add(1)
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(tt.statuses[min(int(call), len(tt.statuses))-1])
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: newTransport(http.DefaultTransport, transportSettings{maxRetries: 2, retryMaxWait: time.Millisecond})}
+			req, err := http.NewRequest(tt.method, server.URL, nil)
+			assert.NoError(t, err)
+			resp, err := client.Do(req)
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedCode, resp.StatusCode)
+			assert.Equal(t, tt.expectedCalls, calls.Load())
+		})
+	}
+}
+
+func TestTransportRetryResendsBody(t *testing.T) {
+	t.Parallel()
+
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newTransport(http.DefaultTransport, transportSettings{maxRetries: 1, retryMaxWait: time.Millisecond})}
+	req, err := http.NewRequest(http.MethodPut, server.URL, strings.NewReader(`{"name":"vpc"}`))
+	assert.NoError(t, err)
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []string{`{"name":"vpc"}`, `{"name":"vpc"}`}, bodies)
+}
+
+func TestRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	wait, ok := retryAfter("7")
+	assert.True(t, ok)
+	assert.Equal(t, 7*time.Second, wait)
+
+	wait, ok = retryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	assert.True(t, ok)
+	assert.InDelta(t, time.Minute, wait, float64(2*time.Second))
+
+	_, ok = retryAfter("")
+	assert.False(t, ok)
+	_, ok = retryAfter("soon")
+	assert.False(t, ok)
+}
+
+func TestTransportRetryWaitIsCapped(t *testing.T) {
+	t.Parallel()
+
+	tr := newTransport(http.DefaultTransport, transportSettings{maxRetries: 3, retryMaxWait: 5 * time.Second})
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"120"}}}
+	assert.Equal(t, 5*time.Second, tr.retryWait(0, resp))
+
+	for attempt := range 10 {
+		assert.LessOrEqual(t, tr.retryWait(attempt, nil), 5*time.Second)
+	}
+}
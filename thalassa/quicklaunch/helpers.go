@@ -0,0 +1,96 @@
+package quicklaunch
+
+import (
+	"strings"
+	"time"
+
+	tcquicklaunch "github.com/thalassa-cloud/client-go/quicklaunch"
+)
+
+// maxLogBytes limits the quick launch logs included in a diagnostic.
+const maxLogBytes = 8 * 1024
+
+var quickLaunchTemplates = []string{
+	string(tcquicklaunch.QuickLaunchTemplateVPC),
+	string(tcquicklaunch.QuickLaunchTemplateKubernetes),
+}
+
+// quickLaunchPhase classifies the free-form status of a quick launch.
+type quickLaunchPhase int
+
+const (
+	quickLaunchPending quickLaunchPhase = iota
+	quickLaunchReady
+	quickLaunchFailed
+)
+
+func phaseOf(status string) quickLaunchPhase {
+	switch strings.ToLower(status) {
+	case "ready", "completed", "succeeded", "active":
+		return quickLaunchReady
+	case "failed", "error":
+		return quickLaunchFailed
+	default:
+		return quickLaunchPending
+	}
+}
+
+func setQuickLaunchState(d interface {
+	Set(string, any) error
+}, ql *tcquicklaunch.QuickLaunch) error {
+	_ = d.Set("name", ql.Name)
+	_ = d.Set("slug", ql.Slug)
+	_ = d.Set("description", ql.Description)
+	if ql.Template != "" {
+		_ = d.Set("template", string(ql.Template))
+	}
+	_ = d.Set("status", ql.Status)
+	_ = d.Set("status_message", ql.StatusMessage)
+	_ = d.Set("vpc_cidr", ql.VpcCidr)
+	_ = d.Set("subnet_cidrs", ql.SubnetCidrs)
+	_ = d.Set("machine_type", ql.MachineType)
+	_ = d.Set("labels", ql.Labels)
+	_ = d.Set("annotations", ql.Annotations)
+	if !ql.CreatedAt.IsZero() {
+		_ = d.Set("created_at", ql.CreatedAt.Format(time.RFC3339))
+	}
+
+	vpcID, clusterID := "", ""
+	subnetIDs := []string{}
+	for _, resource := range ql.Resources {
+		switch resource.Type {
+		case "vpc":
+			vpcID = resource.Identity
+		case "subnet":
+			subnetIDs = append(subnetIDs, resource.Identity)
+		case "kubernetes_cluster":
+			clusterID = resource.Identity
+		}
+	}
+	_ = d.Set("vpc_id", vpcID)
+	_ = d.Set("subnet_ids", subnetIDs)
+	_ = d.Set("kubernetes_cluster_id", clusterID)
+	return d.Set("resources", flattenQuickLaunchResources(ql.Resources))
+}
+
+func flattenQuickLaunchResources(resources tcquicklaunch.QuickLaunchResources) []map[string]any {
+	result := make([]map[string]any, 0, len(resources))
+	for _, resource := range resources {
+		result = append(result, map[string]any{
+			"type":     resource.Type,
+			"name":     resource.Name,
+			"identity": resource.Identity,
+			"status":   resource.LastStatus,
+		})
+	}
+	return result
+}
+
+// truncateLogs keeps the tail of the logs, which holds the cause of a failure.
+func truncateLogs(logs string) string {
+	logs = strings.TrimSpace(logs)
+	if len(logs) <= maxLogBytes {
+		return logs
+	}
+	return "...\n" + logs[len(logs)-maxLogBytes:]
+}
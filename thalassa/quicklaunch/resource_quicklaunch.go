@@ -0,0 +1,344 @@
+package quicklaunch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validate "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/thalassa-cloud/client-go/iaas"
+	tcclient "github.com/thalassa-cloud/client-go/pkg/client"
+	tcquicklaunch "github.com/thalassa-cloud/client-go/quicklaunch"
+	"github.com/thalassa-cloud/client-go/thalassa"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+func ResourceQuickLaunch() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Launch a quick launch stack: a VPC with subnets and a NAT gateway, optionally with a Kubernetes cluster",
+		CreateContext: resourceQuickLaunchCreate,
+		ReadContext:   resourceQuickLaunchRead,
+		UpdateContext: resourceQuickLaunchUpdate,
+		DeleteContext: resourceQuickLaunchDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, _ any) error {
+			if d.Get("machine_type").(string) != "" && d.Get("template").(string) != string(tcquicklaunch.QuickLaunchTemplateKubernetes) {
+				return errors.New("machine_type can only be set with the kubernetes template")
+			}
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Platform identity of the quick launch.",
+			},
+			"organisation_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Organisation ID. Defaults to the provider organisation.",
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Project ID. Defaults to the provider project.",
+			},
+			"template": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      string(tcquicklaunch.QuickLaunchTemplateVPC),
+				ValidateFunc: validate.StringInSlice(quickLaunchTemplates, false),
+				Description:  "Template to launch: `vpc` (VPC, subnets and NAT gateway) or `kubernetes` (adds a Kubernetes cluster and node pool). Defaults to `vpc`.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StringLenBetween(1, 62),
+				Description:  "Base name used as prefix for all launched resources.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StringLenBetween(0, 255),
+				Description:  "Description applied to the launched resources.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Region to launch in (identity, slug or name).",
+			},
+			"vpc_cidr": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.IsCIDR,
+				Description:  "CIDR block of the VPC. Generated when not set.",
+			},
+			"subnet_cidrs": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.IsCIDR},
+				Description: "CIDR blocks of the subnets. Default subnets are created when not set.",
+			},
+			"machine_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Machine type (identity or slug) of the Kubernetes node pool. Only used with the `kubernetes` template.",
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Labels applied to the launched resources.",
+			},
+			"annotations": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Annotations applied to the launched resources.",
+			},
+			"wait_until_ready": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Wait until all launched resources are ready. Defaults to `true`.",
+			},
+			"cascade_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Delete the launched resources together with the quick launch. When `false`, the resources are kept and only the quick launch record is removed. Defaults to `true`.",
+			},
+			"slug": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Slug of the quick launch.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Provisioning status of the quick launch.",
+			},
+			"status_message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Details about the provisioning status.",
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identity of the launched VPC.",
+			},
+			"subnet_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Identities of the launched subnets.",
+			},
+			"kubernetes_cluster_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identity of the launched Kubernetes cluster, when using the `kubernetes` template.",
+			},
+			"resources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "All resources created by the quick launch.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Resource type, e.g. `vpc`, `subnet`, `natgateway`, `kubernetes_cluster` or `kubernetes_node_pool`.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the resource.",
+						},
+						"identity": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Identity of the resource.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Last observed status of the resource.",
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation timestamp (RFC3339).",
+			},
+		},
+	}
+}
+
+func resourceQuickLaunchCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := d.Get("region").(string)
+	regions, err := client.IaaS().ListRegions(ctx, &iaas.ListRegionsRequest{})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("listing regions: %w", err))
+	}
+	found := false
+	for _, r := range regions {
+		if r.Identity == region || r.Slug == region || r.Name == region {
+			region = r.Identity
+			found = true
+			break
+		}
+	}
+	if !found {
+		available := make([]string, len(regions))
+		for i, r := range regions {
+			available[i] = r.Slug
+		}
+		return diag.Errorf("region not found: %s. Available regions: %s", region, strings.Join(available, ", "))
+	}
+
+	ql, err := client.QuickLaunch().CreateQuickLaunch(ctx, tcquicklaunch.QuickLaunchRequest{
+		Template:            tcquicklaunch.QuickLaunchTemplateType(d.Get("template").(string)),
+		Name:                d.Get("name").(string),
+		Description:         d.Get("description").(string),
+		CloudRegionIdentity: region,
+		VpcCidr:             d.Get("vpc_cidr").(string),
+		SubnetCidrs:         convert.ConvertToStringSlice(d.Get("subnet_cidrs")),
+		MachineType:         d.Get("machine_type").(string),
+		Labels:              convert.ConvertToMap(d.Get("labels")),
+		Annotations:         convert.ConvertToMap(d.Get("annotations")),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("creating quick launch: %w", err))
+	}
+	d.SetId(ql.Identity)
+
+	if d.Get("wait_until_ready").(bool) {
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+		defer cancel()
+		ql, err = waitForReadyQuickLaunch(ctxWithTimeout, client, ql.Identity)
+		if err != nil {
+			var failed *errQuickLaunchFailed
+			if errors.As(err, &failed) {
+				if ql != nil {
+					_ = setQuickLaunchState(d, ql)
+				}
+				return quickLaunchFailureDiagnostics(ctx, client, d.Id(), err)
+			}
+			return diag.FromErr(fmt.Errorf("waiting for quick launch: %w", err))
+		}
+	}
+
+	if err := setQuickLaunchState(d, ql); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// quickLaunchFailureDiagnostics reports a failed quick launch together with its logs.
+func quickLaunchFailureDiagnostics(ctx context.Context, client thalassa.Client, identity string, cause error) diag.Diagnostics {
+	detail := cause.Error()
+	logs, err := client.QuickLaunch().GetQuickLaunchLogs(ctx, identity)
+	if err != nil {
+		detail += fmt.Sprintf("\n\nThe quick launch logs could not be retrieved: %s", err)
+	} else if len(logs) > 0 {
+		detail += "\n\nQuick launch logs:\n" + truncateLogs(string(logs))
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  "Quick launch provisioning failed",
+		Detail:   detail,
+	}}
+}
+
+func resourceQuickLaunchRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ql, err := client.QuickLaunch().GetQuickLaunch(ctx, d.Id())
+	if err != nil {
+		if tcclient.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("reading quick launch: %w", err))
+	}
+
+	if err := setQuickLaunchState(d, ql); err != nil {
+		return diag.FromErr(err)
+	}
+	if d.Get("region").(string) == "" {
+		_ = d.Set("region", ql.CloudRegionIdentity)
+	}
+	return nil
+}
+
+// resourceQuickLaunchUpdate only stores wait_until_ready and cascade_delete; all other arguments force a new quick launch.
+func resourceQuickLaunchUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	return resourceQuickLaunchRead(ctx, d, m)
+}
+
+func resourceQuickLaunchDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client, err := provider.GetClient(provider.GetProvider(m), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cascade := tcquicklaunch.QuickLaunchCascadeOrphan
+	if d.Get("cascade_delete").(bool) {
+		cascade = tcquicklaunch.QuickLaunchCascadeDelete
+	}
+
+	if err := client.QuickLaunch().DeleteQuickLaunch(ctx, d.Id(), cascade); err != nil {
+		if tcclient.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("deleting quick launch: %w", err))
+	}
+
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+	if err := waitForDeletedQuickLaunch(ctxWithTimeout, client, d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("waiting for quick launch deletion: %w", err))
+	}
+
+	d.SetId("")
+	return nil
+}
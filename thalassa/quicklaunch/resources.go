@@ -0,0 +1,9 @@
+package quicklaunch
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+var ResourcesMap = map[string]*schema.Resource{
+	"thalassa_quicklaunch": ResourceQuickLaunch(),
+}
+
+var DataSourcesMap = map[string]*schema.Resource{}
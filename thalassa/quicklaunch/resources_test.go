@@ -0,0 +1,73 @@
+package quicklaunch
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	tcquicklaunch "github.com/thalassa-cloud/client-go/quicklaunch"
+)
+
+func TestResourceQuickLaunch(t *testing.T) {
+	resource := ResourceQuickLaunch()
+	s := resource.Schema
+
+	assert.True(t, s["name"].Required)
+	assert.True(t, s["name"].ForceNew)
+	assert.True(t, s["region"].ForceNew)
+	assert.Equal(t, "vpc", s["template"].Default)
+	assert.False(t, s["cascade_delete"].ForceNew)
+	assert.False(t, s["wait_until_ready"].ForceNew)
+	assert.True(t, s["resources"].Computed)
+	assert.NotNil(t, resource.Timeouts.Create)
+	assert.NotNil(t, resource.Importer)
+}
+
+func TestPhaseOf(t *testing.T) {
+	tests := map[string]quickLaunchPhase{
+		"ready":        quickLaunchReady,
+		"Completed":    quickLaunchReady,
+		"failed":       quickLaunchFailed,
+		"Error":        quickLaunchFailed,
+		"provisioning": quickLaunchPending,
+		"":             quickLaunchPending,
+	}
+	for status, want := range tests {
+		assert.Equal(t, want, phaseOf(status), status)
+	}
+}
+
+func TestSetQuickLaunchState(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceQuickLaunch().Schema, map[string]any{})
+	ql := &tcquicklaunch.QuickLaunch{
+		Identity: "ql-123",
+		Name:     "demo",
+		Template: tcquicklaunch.QuickLaunchTemplateKubernetes,
+		Status:   "ready",
+		Resources: tcquicklaunch.QuickLaunchResources{
+			{Type: "vpc", Name: "demo", Identity: "vpc-1"},
+			{Type: "subnet", Name: "demo-a", Identity: "subnet-a"},
+			{Type: "subnet", Name: "demo-b", Identity: "subnet-b"},
+			{Type: "kubernetes_cluster", Name: "demo", Identity: "k8s-1", LastStatus: "ready"},
+		},
+	}
+
+	assert.NoError(t, setQuickLaunchState(d, ql))
+	assert.Equal(t, "vpc-1", d.Get("vpc_id"))
+	assert.Equal(t, []any{"subnet-a", "subnet-b"}, d.Get("subnet_ids"))
+	assert.Equal(t, "k8s-1", d.Get("kubernetes_cluster_id"))
+	assert.Equal(t, 4, d.Get("resources.#"))
+	assert.Equal(t, "ready", d.Get("resources.3.status"))
+}
+
+func TestTruncateLogs(t *testing.T) {
+	assert.Equal(t, "short", truncateLogs("  short\n"))
+
+	long := strings.Repeat("a", maxLogBytes) + "the cause"
+	truncated := truncateLogs(long)
+	assert.True(t, strings.HasPrefix(truncated, "...\n"))
+	assert.True(t, strings.HasSuffix(truncated, "the cause"))
+	assert.Len(t, truncated, maxLogBytes+4)
+}
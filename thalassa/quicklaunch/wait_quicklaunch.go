@@ -0,0 +1,73 @@
+package quicklaunch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	tcquicklaunch "github.com/thalassa-cloud/client-go/quicklaunch"
+	"github.com/thalassa-cloud/client-go/thalassa"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/waiter"
+)
+
+// Wait states of a quick launch, see phaseOf.
+const (
+	quickLaunchStateReady   = "ready"
+	quickLaunchStateFailed  = "failed"
+	quickLaunchStatePending = "pending"
+)
+
+// errQuickLaunchFailed is returned when provisioning of the quick launch failed.
+type errQuickLaunchFailed struct {
+	identity      string
+	statusMessage string
+}
+
+func (e *errQuickLaunchFailed) Error() string {
+	return fmt.Sprintf("quick launch %q failed: %s", e.identity, e.statusMessage)
+}
+
+func quickLaunchWaiter(client thalassa.Client, identity string) waiter.Waiter[*tcquicklaunch.QuickLaunch] {
+	return waiter.Waiter[*tcquicklaunch.QuickLaunch]{
+		Description: fmt.Sprintf("quick launch %q", identity),
+		Refresh: func(ctx context.Context) (*tcquicklaunch.QuickLaunch, error) {
+			return client.QuickLaunch().GetQuickLaunch(ctx, identity)
+		},
+		State: func(ql *tcquicklaunch.QuickLaunch) string {
+			switch phaseOf(ql.Status) {
+			case quickLaunchReady:
+				return quickLaunchStateReady
+			case quickLaunchFailed:
+				return quickLaunchStateFailed
+			default:
+				return quickLaunchStatePending
+			}
+		},
+		Message: func(ql *tcquicklaunch.QuickLaunch) string { return ql.StatusMessage },
+		Failed:  []string{quickLaunchStateFailed},
+		// A quick launch provisions a whole environment, so there is no point in polling often.
+		MinInterval: 5 * time.Second,
+		MaxInterval: 30 * time.Second,
+	}
+}
+
+func waitForReadyQuickLaunch(ctx context.Context, client thalassa.Client, identity string) (*tcquicklaunch.QuickLaunch, error) {
+	w := quickLaunchWaiter(client, identity)
+	w.Target = []string{quickLaunchStateReady}
+	ql, err := w.Wait(ctx)
+	var waitErr *waiter.Error
+	if errors.As(err, &waitErr) && !waitErr.Timeout {
+		return ql, &errQuickLaunchFailed{identity: identity, statusMessage: waitErr.Message}
+	}
+	return ql, err
+}
+
+func waitForDeletedQuickLaunch(ctx context.Context, client thalassa.Client, identity string) error {
+	w := quickLaunchWaiter(client, identity)
+	w.TargetNotFound = true
+	w.Failed = []string{}
+	_, err := w.Wait(ctx)
+	return err
+}
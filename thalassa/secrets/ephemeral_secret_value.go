@@ -0,0 +1,133 @@
+package secrets
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	tcsecrets "github.com/thalassa-cloud/client-go/secrets"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &secretValueEphemeralResource{}
+
+// NewSecretValueEphemeralResource returns the thalassa_secret_value ephemeral resource.
+func NewSecretValueEphemeralResource() ephemeral.EphemeralResource {
+	return &secretValueEphemeralResource{}
+}
+
+type secretValueEphemeralResource struct {
+	provider.EphemeralResourceBase
+}
+
+type secretValueModel struct {
+	OrganisationID  types.String `tfsdk:"organisation_id"`
+	ProjectID       types.String `tfsdk:"project_id"`
+	Region          types.String `tfsdk:"region"`
+	Path            types.String `tfsdk:"path"`
+	Version         types.Int64  `tfsdk:"version"`
+	SecretString    types.String `tfsdk:"secret_string"`
+	SecretKeyValues types.Map    `tfsdk:"secret_key_values"`
+	KmsKeyID        types.String `tfsdk:"kms_key_id"`
+}
+
+func (r *secretValueEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret_value"
+}
+
+func (r *secretValueEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Read the value of a secret in Thalassa Secrets Manager without storing it in the Terraform plan or state",
+		Attributes: map[string]schema.Attribute{
+			"organisation_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Organisation ID. Defaults to the provider organisation.",
+			},
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Project ID. Defaults to the provider project.",
+			},
+			"region": schema.StringAttribute{
+				Required:    true,
+				Description: "Region slug where the secret is stored.",
+			},
+			"path": schema.StringAttribute{
+				Required:    true,
+				Description: "Absolute secret path (must start with /).",
+			},
+			"version": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Version to read. Defaults to the current version.",
+			},
+			"secret_string": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Decoded string value of the secret, if it holds a string.",
+			},
+			"secret_key_values": schema.MapAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Description: "Key/value pairs of the secret, if it holds key/value pairs.",
+			},
+			"kms_key_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "KMS key identity used to encrypt the secret.",
+			},
+		},
+	}
+}
+
+func (r *secretValueEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data secretValueModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.Client(data.OrganisationID, data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var version *int
+	if !data.Version.IsNull() && !data.Version.IsUnknown() {
+		version = convert.Ptr(int(data.Version.ValueInt64()))
+	}
+
+	region, path := data.Region.ValueString(), data.Path.ValueString()
+	value, err := client.Secrets().GetSecretValue(ctx, region, path, version)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read secret value", err.Error())
+		return
+	}
+
+	data.Version = types.Int64Value(int64(value.Version))
+	data.KmsKeyID = types.StringValue(value.KmsKeyIdentity)
+	data.SecretString = types.StringNull()
+	if value.SecretString != "" {
+		plaintext, err := tcsecrets.DecodeBytes("secretString", value.SecretString)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to decode secret value", err.Error())
+			return
+		}
+		data.SecretString = types.StringValue(string(plaintext))
+	}
+	data.SecretKeyValues = types.MapNull(types.StringType)
+	if len(value.SecretKeyValues) > 0 {
+		keyValues, diags := types.MapValueFrom(ctx, types.StringType, value.SecretKeyValues)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.SecretKeyValues = keyValues
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -2,16 +2,21 @@ package secrets
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
 	tcclient "github.com/thalassa-cloud/client-go/pkg/client"
 	tcsecrets "github.com/thalassa-cloud/client-go/secrets"
-)
 
-const timeFormatRFC3339 = time.RFC3339
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/convert"
+)
 
 func secretID(region, path string) string {
 	return region + path
@@ -69,17 +74,46 @@ func setSecretState(d interface {
 		_ = d.Set("kms_key_id", secret.KmsKey.Identity)
 	}
 	if !secret.CreatedAt.IsZero() {
-		_ = d.Set("created_at", secret.CreatedAt.Format(timeFormatRFC3339))
+		_ = d.Set("created_at", secret.CreatedAt.Format(time.RFC3339))
 	}
 	if !secret.UpdatedAt.IsZero() {
-		_ = d.Set("updated_at", secret.UpdatedAt.Format(timeFormatRFC3339))
+		_ = d.Set("updated_at", secret.UpdatedAt.Format(time.RFC3339))
 	}
 	if secret.LastAccessedAt != nil {
-		_ = d.Set("last_accessed_at", secret.LastAccessedAt.Format(timeFormatRFC3339))
+		_ = d.Set("last_accessed_at", secret.LastAccessedAt.Format(time.RFC3339))
 	}
 	return nil
 }
 
+// writeOnlySecretValue reads the secret_string_wo and secret_key_values_wo arguments from the configuration.
+func writeOnlySecretValue(d convert.RawConfigGetter) (secretString string, keyValues map[string]string, diags diag.Diagnostics) {
+	secretString, diags = convert.WriteOnlyString(d, "secret_string_wo")
+	if diags.HasError() {
+		return "", nil, diags
+	}
+	rawKeyValues, diags := convert.WriteOnlyString(d, "secret_key_values_wo")
+	if diags.HasError() {
+		return "", nil, diags
+	}
+	if rawKeyValues == "" {
+		return secretString, nil, nil
+	}
+	keyValues, err := parseSecretKeyValues(rawKeyValues)
+	if err != nil {
+		return "", nil, diag.FromErr(err)
+	}
+	return secretString, keyValues, nil
+}
+
+// parseSecretKeyValues parses a JSON object of string values, as write-only arguments cannot be maps.
+func parseSecretKeyValues(raw string) (map[string]string, error) {
+	var keyValues map[string]string
+	if err := json.Unmarshal([]byte(raw), &keyValues); err != nil {
+		return nil, fmt.Errorf("secret_key_values_wo must be a JSON object of string values: %w", err)
+	}
+	return keyValues, nil
+}
+
 func expandGenerateSecret(raw []any) *tcsecrets.GenerateSecret {
 	if len(raw) == 0 {
 		return nil
@@ -163,3 +197,21 @@ func secretVersionExists(ctx context.Context, client interface {
 
 	return false, err
 }
+
+// validateWriteOnlySecretVersion fails the validation when secret_wo_version is set without secret_string_wo or
+// secret_key_values_wo, as changing the version would then replace the secret without a value to write.
+func validateWriteOnlySecretVersion(_ context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+	config := req.RawConfig
+	if config.IsNull() || !config.IsKnown() || config.GetAttr("secret_wo_version").IsNull() {
+		return
+	}
+	if !config.GetAttr("secret_string_wo").IsNull() || !config.GetAttr("secret_key_values_wo").IsNull() {
+		return
+	}
+	resp.Diagnostics = append(resp.Diagnostics, diag.Diagnostic{
+		Severity:      diag.Error,
+		Summary:       "Missing required argument",
+		Detail:        `"secret_wo_version": one of ` + "`secret_key_values_wo,secret_string_wo`" + ` must be specified`,
+		AttributePath: cty.GetAttrPath("secret_wo_version"),
+	})
+}
@@ -25,6 +25,7 @@ func ResourceSecret() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceSecretImport,
 		},
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{validateWriteOnlySecretVersion},
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -37,6 +38,12 @@ func ResourceSecret() *schema.Resource {
 				ForceNew:    true,
 				Description: "Organisation ID. Defaults to the provider organisation.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Project ID. Defaults to the provider project.",
+			},
 			"region": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -78,7 +85,7 @@ func ResourceSecret() *schema.Resource {
 				Optional:      true,
 				MaxItems:      1,
 				ForceNew:      true,
-				ConflictsWith: []string{"secret_string", "secret_key_values"},
+				ConflictsWith: []string{"secret_string", "secret_key_values", "secret_string_wo", "secret_key_values_wo"},
 				Description:   "Generate a random secret value on create. Mutually exclusive with secret_string and secret_key_values.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -95,17 +102,41 @@ func ResourceSecret() *schema.Resource {
 				Optional:      true,
 				Sensitive:     true,
 				ForceNew:      true,
-				ConflictsWith: []string{"generate_secret", "secret_key_values"},
-				Description:   "Initial secret string value (create only; not returned on read).",
+				ConflictsWith: []string{"generate_secret", "secret_key_values", "secret_string_wo", "secret_key_values_wo"},
+				Description:   "Initial secret string value (create only; not returned on read). The value is stored in the Terraform state; use secret_string_wo to keep it out of the state.",
 			},
 			"secret_key_values": {
 				Type:          schema.TypeMap,
 				Optional:      true,
 				Sensitive:     true,
 				ForceNew:      true,
-				ConflictsWith: []string{"generate_secret", "secret_string"},
+				ConflictsWith: []string{"generate_secret", "secret_string", "secret_string_wo", "secret_key_values_wo"},
 				Elem:          &schema.Schema{Type: schema.TypeString},
-				Description:   "Initial key-value secret payload (create only; not returned on read).",
+				Description:   "Initial key-value secret payload (create only; not returned on read). The value is stored in the Terraform state; use secret_key_values_wo to keep it out of the state.",
+			},
+			"secret_string_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				ConflictsWith: []string{"generate_secret", "secret_string", "secret_key_values", "secret_key_values_wo"},
+				RequiredWith:  []string{"secret_wo_version"},
+				Description:   "Initial secret string value as a write-only argument that is never stored in the Terraform plan or state. Requires Terraform 1.11 or later and secret_wo_version.",
+			},
+			"secret_key_values_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				ConflictsWith: []string{"generate_secret", "secret_string", "secret_key_values", "secret_string_wo"},
+				RequiredWith:  []string{"secret_wo_version"},
+				Description:   "Initial key-value secret payload as a JSON object of strings (e.g. jsonencode({...})), as a write-only argument that is never stored in the Terraform plan or state. Requires Terraform 1.11 or later and secret_wo_version.",
+			},
+			"secret_wo_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Version of the write-only secret value. Changing it replaces the secret with one holding the current secret_string_wo or secret_key_values_wo.",
 			},
 			"current_version": {
 				Type:        schema.TypeInt,
@@ -161,6 +192,16 @@ func resourceSecretCreate(ctx context.Context, d *schema.ResourceData, m any) di
 	if v, ok := d.GetOk("secret_key_values"); ok {
 		createReq.SecretKeyValues = convert.ConvertToMap(v)
 	}
+	secretString, keyValues, diags := writeOnlySecretValue(d)
+	if diags.HasError() {
+		return diags
+	}
+	if secretString != "" {
+		createReq.SecretString = tcsecrets.EncodeBytes([]byte(secretString))
+	}
+	if len(keyValues) > 0 {
+		createReq.SecretKeyValues = keyValues
+	}
 	if v, ok := d.GetOk("generate_secret"); ok {
 		createReq.GenerateSecret = expandGenerateSecret(v.([]any))
 	}
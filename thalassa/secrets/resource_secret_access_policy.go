@@ -35,6 +35,12 @@ func ResourceSecretAccessPolicy() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"region": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -23,6 +23,7 @@ func ResourceSecretVersion() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceSecretVersionImport,
 		},
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{validateWriteOnlySecretVersion},
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -34,6 +35,12 @@ func ResourceSecretVersion() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"region": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -55,23 +62,48 @@ func ResourceSecretVersion() *schema.Resource {
 				Optional:      true,
 				ForceNew:      true,
 				Sensitive:     true,
-				ConflictsWith: []string{"secret_key_values", "generate_secret"},
-				Description:   "Secret string value (not returned on read).",
+				ConflictsWith: []string{"secret_key_values", "generate_secret", "secret_string_wo", "secret_key_values_wo"},
+				Description:   "Secret string value (not returned on read). The value is stored in the Terraform state; use secret_string_wo to keep it out of the state.",
 			},
 			"secret_key_values": {
 				Type:          schema.TypeMap,
 				Optional:      true,
 				ForceNew:      true,
 				Sensitive:     true,
-				ConflictsWith: []string{"secret_string", "generate_secret"},
+				ConflictsWith: []string{"secret_string", "generate_secret", "secret_string_wo", "secret_key_values_wo"},
 				Elem:          &schema.Schema{Type: schema.TypeString},
+				Description:   "Key-value secret payload (not returned on read). The value is stored in the Terraform state; use secret_key_values_wo to keep it out of the state.",
+			},
+			"secret_string_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				ConflictsWith: []string{"generate_secret", "secret_string", "secret_key_values", "secret_key_values_wo"},
+				RequiredWith:  []string{"secret_wo_version"},
+				Description:   "Secret string value as a write-only argument that is never stored in the Terraform plan or state. Requires Terraform 1.11 or later and secret_wo_version.",
+			},
+			"secret_key_values_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				ConflictsWith: []string{"generate_secret", "secret_string", "secret_key_values", "secret_string_wo"},
+				RequiredWith:  []string{"secret_wo_version"},
+				Description:   "Key-value secret payload as a JSON object of strings (e.g. jsonencode({...})), as a write-only argument that is never stored in the Terraform plan or state. Requires Terraform 1.11 or later and secret_wo_version.",
+			},
+			"secret_wo_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Version of the write-only secret value. Changing it creates a new secret version holding the current secret_string_wo or secret_key_values_wo.",
 			},
 			"generate_secret": {
 				Type:          schema.TypeList,
 				Optional:      true,
 				ForceNew:      true,
 				MaxItems:      1,
-				ConflictsWith: []string{"secret_string", "secret_key_values"},
+				ConflictsWith: []string{"secret_string", "secret_key_values", "secret_string_wo", "secret_key_values_wo"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"byte_length": {
@@ -113,12 +145,22 @@ func resourceSecretVersionCreate(ctx context.Context, d *schema.ResourceData, m
 	if v, ok := d.GetOk("secret_key_values"); ok {
 		putReq.SecretKeyValues = convert.ConvertToMap(v)
 	}
+	secretString, keyValues, diags := writeOnlySecretValue(d)
+	if diags.HasError() {
+		return diags
+	}
+	if secretString != "" {
+		putReq.SecretString = tcsecrets.EncodeBytes([]byte(secretString))
+	}
+	if len(keyValues) > 0 {
+		putReq.SecretKeyValues = keyValues
+	}
 	if v, ok := d.GetOk("generate_secret"); ok {
 		putReq.GenerateSecret = expandGenerateSecret(v.([]any))
 	}
 
 	if putReq.SecretString == "" && len(putReq.SecretKeyValues) == 0 && putReq.GenerateSecret == nil {
-		return diag.Errorf("one of secret_string, secret_key_values, or generate_secret must be set")
+		return diag.Errorf("one of secret_string, secret_key_values, secret_string_wo, secret_key_values_wo, or generate_secret must be set")
 	}
 
 	result, err := client.Secrets().PutSecretValue(ctx, region, path, putReq)
@@ -1,6 +1,9 @@
 package secrets
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
 
 var ResourcesMap = map[string]*schema.Resource{
 	"thalassa_secret":               ResourceSecret(),
@@ -9,3 +12,7 @@ var ResourcesMap = map[string]*schema.Resource{
 }
 
 var DataSourcesMap = map[string]*schema.Resource{}
+
+var EphemeralResources = []func() ephemeral.EphemeralResource{
+	NewSecretValueEphemeralResource,
+}
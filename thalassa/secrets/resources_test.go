@@ -1,8 +1,10 @@
 package secrets
 
 import (
+	"context"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 
@@ -18,6 +20,9 @@ func TestResourceSecret(t *testing.T) {
 	assert.True(t, schema["path"].Required)
 	assert.True(t, schema["kms_key_id"].Required)
 	assert.True(t, schema["secret_string"].Sensitive)
+	assert.True(t, schema["secret_string_wo"].WriteOnly)
+	assert.True(t, schema["secret_key_values_wo"].WriteOnly)
+	assert.True(t, schema["secret_wo_version"].ForceNew)
 	assert.NotNil(t, resource.Importer)
 }
 
@@ -26,6 +31,50 @@ func TestResourceSecretVersion(t *testing.T) {
 	assert.True(t, resource.Schema["secret_string"].Sensitive)
 	assert.NotNil(t, resource.Schema["generate_secret"].Elem.(*schema.Resource).Schema["byte_length"])
 	assert.NotNil(t, resource.DeleteContext)
+	assert.True(t, resource.Schema["secret_string_wo"].WriteOnly)
+	assert.True(t, resource.Schema["secret_key_values_wo"].WriteOnly)
+}
+
+func TestValidateWriteOnlySecretVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  map[string]cty.Value
+		wantErr bool
+	}{
+		{name: "no write-only arguments", config: map[string]cty.Value{"secret_string": cty.StringVal("value")}},
+		{name: "version with write-only string", config: map[string]cty.Value{"secret_wo_version": cty.NumberIntVal(1), "secret_string_wo": cty.StringVal("value")}},
+		{name: "version with write-only key values", config: map[string]cty.Value{"secret_wo_version": cty.NumberIntVal(1), "secret_key_values_wo": cty.StringVal(`{"a":"b"}`)}},
+		{name: "version without write-only value", config: map[string]cty.Value{"secret_wo_version": cty.NumberIntVal(1)}, wantErr: true},
+	}
+
+	for _, resource := range []*schema.Resource{ResourceSecret(), ResourceSecretVersion()} {
+		configType := resource.CoreConfigSchema().ImpliedType()
+		for _, tt := range tests {
+			attributes := map[string]cty.Value{}
+			for name, attributeType := range configType.AttributeTypes() {
+				attributes[name] = cty.NullVal(attributeType)
+			}
+			for name, value := range tt.config {
+				attributes[name] = value
+			}
+
+			resp := &schema.ValidateResourceConfigFuncResponse{}
+			validateWriteOnlySecretVersion(context.Background(), schema.ValidateResourceConfigFuncRequest{RawConfig: cty.ObjectVal(attributes)}, resp)
+			assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError(), tt.name)
+		}
+	}
+}
+
+func TestParseSecretKeyValues(t *testing.T) {
+	keyValues, err := parseSecretKeyValues(`{"username":"app","password":"s3cret"}`)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"username": "app", "password": "s3cret"}, keyValues)
+
+	_, err = parseSecretKeyValues(`{"port":5432}`)
+	assert.Error(t, err)
+
+	_, err = parseSecretKeyValues(`not json`)
+	assert.Error(t, err)
 }
 
 func TestParseSecretID(t *testing.T) {
@@ -0,0 +1,226 @@
+package thalassa
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/provider"
+)
+
+func TestProviderServerSchemas(t *testing.T) {
+	providerServer, err := ProviderServer(context.Background())
+	assert.NoError(t, err)
+
+	resp, err := providerServer().GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	assert.NoError(t, err)
+	for _, diag := range resp.Diagnostics {
+		assert.NotEqual(t, tfprotov5.DiagnosticSeverityError, diag.Severity, "%s: %s", diag.Summary, diag.Detail)
+	}
+
+	assert.Contains(t, resp.ResourceSchemas, "thalassa_kubernetes_cluster")
+	assert.Contains(t, resp.DataSourceSchemas, "thalassa_kubernetes_cluster_session_token")
+	for _, name := range []string{
+		"thalassa_kubernetes_cluster_session_token",
+		"thalassa_kubernetes_kubeconfig",
+		"thalassa_secret_value",
+	} {
+		assert.Contains(t, resp.EphemeralResourceSchemas, name)
+	}
+	for _, name := range []string{
+		"principal_arn",
+		"parse_principal_arn",
+		"bucket_policy_document",
+		"subnet_cidrs",
+	} {
+		assert.Contains(t, resp.Functions, name)
+	}
+	for _, name := range []string{
+		"thalassa_machine_start",
+		"thalassa_machine_stop",
+		"thalassa_machine_restart",
+		"thalassa_kms_key_rotate",
+		"thalassa_container_registry_retention_policy_run",
+		"thalassa_kubernetes_node_pool_machine_replace",
+		"thalassa_dbaas_db_cluster_backup",
+	} {
+		assert.Contains(t, resp.ActionSchemas, name)
+	}
+	for _, name := range []string{
+		"thalassa_vpc",
+		"thalassa_subnet",
+		"thalassa_security_group",
+		"thalassa_route_table",
+		"thalassa_natgateway",
+		"thalassa_loadbalancer",
+		"thalassa_virtual_machine_instance",
+		"thalassa_block_volume",
+		"thalassa_kubernetes_cluster",
+		"thalassa_dbaas_db_cluster",
+		"thalassa_dns_zone",
+	} {
+		assert.Contains(t, resp.ListResourceSchemas, name)
+	}
+
+	identityResp, err := providerServer().GetResourceIdentitySchemas(context.Background(), &tfprotov5.GetResourceIdentitySchemasRequest{})
+	assert.NoError(t, err)
+	assert.Contains(t, identityResp.IdentitySchemas, "thalassa_vpc")
+}
+
+func TestProviderServerListResource(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		vpc := `{"identity":"vpc-1","name":"main","cidrs":["10.0.0.0/16"],"labels":{"env":"prod"},"cloudRegion":{"identity":"region-1","slug":"nl-01"},"status":"ready"}`
+		switch r.URL.Path {
+		case "/v1/vpcs":
+			assert.Equal(t, "nl-01", r.URL.Query().Get("region"))
+			assert.Equal(t, "prod", r.URL.Query().Get("matchLabels[env]"))
+			_, _ = w.Write([]byte("[" + vpc + "]"))
+		case "/v1/vpcs/vpc-1":
+			_, _ = w.Write([]byte(vpc))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer api.Close()
+
+	providerServer, err := ProviderServer(context.Background())
+	assert.NoError(t, err)
+	server := providerServer()
+
+	schemaResp, err := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	assert.NoError(t, err)
+
+	providerAttributes := map[string]tftypes.Value{}
+	providerType := schemaResp.Provider.ValueType().(tftypes.Object)
+	for name, typ := range providerType.AttributeTypes {
+		providerAttributes[name] = tftypes.NewValue(typ, nil)
+	}
+	providerAttributes["api"] = tftypes.NewValue(tftypes.String, api.URL)
+	providerAttributes["token"] = tftypes.NewValue(tftypes.String, "test-token")
+	providerAttributes["organisation_id"] = tftypes.NewValue(tftypes.String, "org-test")
+	providerConfig, err := tfprotov5.NewDynamicValue(providerType, tftypes.NewValue(providerType, providerAttributes))
+	assert.NoError(t, err)
+
+	configureResp, err := server.ConfigureProvider(context.Background(), &tfprotov5.ConfigureProviderRequest{Config: &providerConfig})
+	assert.NoError(t, err)
+	assert.Empty(t, configureResp.Diagnostics)
+
+	listType := schemaResp.ListResourceSchemas["thalassa_vpc"].ValueType()
+	listConfig, err := tfprotov5.NewDynamicValue(listType, tftypes.NewValue(listType, map[string]tftypes.Value{
+		"region": tftypes.NewValue(tftypes.String, "nl-01"),
+		"labels": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"env": tftypes.NewValue(tftypes.String, "prod"),
+		}),
+	}))
+	assert.NoError(t, err)
+
+	listServer, ok := server.(tfprotov5.ListResourceServer)
+	if !assert.True(t, ok) {
+		return
+	}
+	stream, err := listServer.ListResource(context.Background(), &tfprotov5.ListResourceRequest{
+		TypeName:        "thalassa_vpc",
+		Config:          &listConfig,
+		IncludeResource: true,
+	})
+	assert.NoError(t, err)
+
+	var results []tfprotov5.ListResourceResult
+	for result := range stream.Results {
+		results = append(results, result)
+	}
+	if !assert.Len(t, results, 1) {
+		return
+	}
+	assert.Empty(t, results[0].Diagnostics)
+	assert.Equal(t, "main", results[0].DisplayName)
+
+	identityType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}}
+	identity, err := results[0].Identity.IdentityData.Unmarshal(identityType)
+	assert.NoError(t, err)
+	var identityAttributes map[string]tftypes.Value
+	assert.NoError(t, identity.As(&identityAttributes))
+	var id string
+	assert.NoError(t, identityAttributes["id"].As(&id))
+	assert.Equal(t, "vpc-1", id)
+
+	state, err := results[0].Resource.Unmarshal(schemaResp.ResourceSchemas["thalassa_vpc"].ValueType())
+	assert.NoError(t, err)
+	var stateAttributes map[string]tftypes.Value
+	assert.NoError(t, state.As(&stateAttributes))
+	var name, region string
+	assert.NoError(t, stateAttributes["name"].As(&name))
+	assert.NoError(t, stateAttributes["region"].As(&region))
+	assert.Equal(t, "main", name)
+	assert.Equal(t, "nl-01", region)
+}
+
+func TestProviderServerFunctions(t *testing.T) {
+	providerServer, err := ProviderServer(context.Background())
+	assert.NoError(t, err)
+	server := providerServer()
+
+	stringList := tftypes.List{ElementType: tftypes.String}
+	statementType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"effect":     tftypes.String,
+		"principals": stringList,
+		"actions":    stringList,
+		"resources":  stringList,
+	}}
+	statements := tftypes.NewValue(tftypes.List{ElementType: statementType}, []tftypes.Value{
+		tftypes.NewValue(statementType, map[string]tftypes.Value{
+			"effect":     tftypes.NewValue(tftypes.String, "Allow"),
+			"principals": tftypes.NewValue(stringList, []tftypes.Value{tftypes.NewValue(tftypes.String, "*")}),
+			"actions":    tftypes.NewValue(stringList, []tftypes.Value{tftypes.NewValue(tftypes.String, "s3:GetObject")}),
+			"resources":  tftypes.NewValue(stringList, []tftypes.Value{tftypes.NewValue(tftypes.String, "arn:thalassa:s3:::example/*")}),
+		}),
+	})
+	argument, err := tfprotov5.NewDynamicValue(statements.Type(), statements)
+	assert.NoError(t, err)
+
+	resp, err := server.CallFunction(context.Background(), &tfprotov5.CallFunctionRequest{
+		Name:      "bucket_policy_document",
+		Arguments: []*tfprotov5.DynamicValue{&argument},
+	})
+	assert.NoError(t, err)
+	assert.Nil(t, resp.Error)
+
+	result, err := resp.Result.Unmarshal(tftypes.String)
+	assert.NoError(t, err)
+	var policy string
+	assert.NoError(t, result.As(&policy))
+	assert.Contains(t, policy, `"Thalassa":["*"]`)
+}
+
+func TestFrameworkProviderSharesConfiguredProvider(t *testing.T) {
+	sdkProvider := Provider()
+	diags := sdkProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]any{
+		"api":             "https://api.example.com",
+		"token":           "test-token",
+		"organisation_id": "org-test",
+	}))
+	assert.False(t, diags.HasError())
+
+	p := NewFrameworkProvider(sdkProvider)
+	config := tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{}}, map[string]tftypes.Value{})
+	resp := &fwprovider.ConfigureResponse{}
+	p.Configure(context.Background(), fwprovider.ConfigureRequest{Config: tfsdk.Config{Raw: config}}, resp)
+	assert.False(t, resp.Diagnostics.HasError())
+
+	sdkConfigured := sdkProvider.Meta().(provider.ConfiguredProvider)
+	for _, data := range []any{resp.EphemeralResourceData, resp.ActionData, resp.ListResourceData} {
+		configured, ok := data.(provider.ConfiguredProvider)
+		if assert.True(t, ok) {
+			assert.Same(t, sdkConfigured.Client, configured.Client)
+		}
+	}
+}
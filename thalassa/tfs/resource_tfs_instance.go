@@ -27,6 +27,10 @@ func resourceTfsInstance() *schema.Resource {
 		ReadContext:   resourceTfsInstanceRead,
 		UpdateContext: resourceTfsInstanceUpdate,
 		DeleteContext: resourceTfsInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -38,6 +42,12 @@ func resourceTfsInstance() *schema.Resource {
 				ForceNew:    true,
 				Description: "Reference to the Organisation of the TFS Instance. If not provided, the organisation of the (Terraform) provider will be used.",
 			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the Project of the resource. If not provided, the project of the (Terraform) provider will be used.",
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -234,10 +244,10 @@ func resourceTfsInstanceCreate(ctx context.Context, d *schema.ResourceData, m an
 	}
 
 	if d.Get("wait_until_available").(bool) {
-		ctxWithTimeout, cancel := context.WithTimeout(ctx, 30*time.Minute)
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
 		defer cancel()
 
-		err = client.Tfs().WaitUntilTfsInstanceIsAvailable(ctxWithTimeout, tfsInstance.Identity)
+		err = waitForAvailableTfsInstance(ctxWithTimeout, client, tfsInstance.Identity)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("failed to wait for TFS instance to be available: %w", err))
 		}
@@ -421,14 +431,12 @@ func resourceTfsInstanceDelete(ctx context.Context, d *schema.ResourceData, m an
 		}
 	}
 
-	ctxWithTimeout, cancel := context.WithTimeout(ctx, 10*time.Minute)
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
 	defer cancel()
 
-	err = client.Tfs().WaitUntilTfsInstanceIsDeleted(ctxWithTimeout, identity)
+	err = waitForDeletedTfsInstance(ctxWithTimeout, client, identity)
 	if err != nil {
-		if !strings.Contains(err.Error(), "timeout") {
-			return diag.FromErr(fmt.Errorf("failed to wait for TFS instance deletion: %w", err))
-		}
+		return diag.FromErr(fmt.Errorf("failed to wait for TFS instance deletion: %w", err))
 	}
 
 	d.SetId("")
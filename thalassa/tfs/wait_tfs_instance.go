@@ -0,0 +1,37 @@
+package tfs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thalassa-cloud/client-go/tfs"
+	"github.com/thalassa-cloud/client-go/thalassa"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/waiter"
+)
+
+func tfsInstanceWaiter(client thalassa.Client, identity string) waiter.Waiter[*tfs.TfsInstance] {
+	return waiter.Waiter[*tfs.TfsInstance]{
+		Description: fmt.Sprintf("TFS instance %q", identity),
+		Refresh: func(ctx context.Context) (*tfs.TfsInstance, error) {
+			return client.Tfs().GetTfsInstance(ctx, identity)
+		},
+		State:  func(instance *tfs.TfsInstance) string { return string(instance.Status) },
+		Failed: []string{string(tfs.TfsStatusError)},
+	}
+}
+
+func waitForAvailableTfsInstance(ctx context.Context, client thalassa.Client, identity string) error {
+	w := tfsInstanceWaiter(client, identity)
+	w.Target = []string{string(tfs.TfsStatusAvailable)}
+	_, err := w.Wait(ctx)
+	return err
+}
+
+func waitForDeletedTfsInstance(ctx context.Context, client thalassa.Client, identity string) error {
+	w := tfsInstanceWaiter(client, identity)
+	w.Target = []string{string(tfs.TfsStatusDeleted)}
+	w.TargetNotFound = true
+	_, err := w.Wait(ctx)
+	return err
+}
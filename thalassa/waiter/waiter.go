@@ -0,0 +1,211 @@
+// Package waiter polls Thalassa Cloud objects until they reach a target state.
+//
+// Waits back off exponentially with jitter, so long running operations on large applies do not exhaust the API
+// rate limit, and report the last state and status message of the object when they fail.
+package waiter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	tcclient "github.com/thalassa-cloud/client-go/pkg/client"
+)
+
+const (
+	// DefaultMinInterval is the default delay between the first and the second poll.
+	DefaultMinInterval = time.Second
+	// DefaultMaxInterval is the default upper bound of the delay between polls.
+	DefaultMaxInterval = 15 * time.Second
+	// DefaultNotFoundChecks is the default number of consecutive not found results tolerated while waiting for an
+	// object to reach a target state, to cover objects that are not visible yet right after they were created.
+	DefaultNotFoundChecks = 3
+)
+
+// DefaultFailed are the states that end a wait with an error when Waiter.Failed is not set.
+var DefaultFailed = []string{"error", "failed"}
+
+// ErrNotFound can be returned by a refresh function to report that the object does not exist. Not found errors of
+// the Thalassa Cloud client and nil objects are treated the same way.
+var ErrNotFound = errors.New("not found")
+
+// Waiter waits for an object of type T to reach one of the target states. States are compared case-insensitively.
+type Waiter[T any] struct {
+	// Description names the object in log entries and errors, e.g. `virtual machine instance "vm-123"`.
+	Description string
+	// Refresh fetches the current object.
+	Refresh func(ctx context.Context) (T, error)
+	// State returns the state of the object.
+	State func(T) string
+	// Message returns the status message of the object. Optional.
+	Message func(T) string
+
+	// Target are the states that end the wait successfully.
+	Target []string
+	// Pending are the states in which the wait continues. When empty, every state that is not a target or failed
+	// state is pending; otherwise any other state ends the wait with an error.
+	Pending []string
+	// Failed are the states that end the wait with an error. Defaults to DefaultFailed.
+	Failed []string
+
+	// TargetNotFound ends the wait successfully when the object no longer exists, for deletions.
+	TargetNotFound bool
+	// NotFoundChecks is the number of consecutive not found results tolerated before the wait fails. Defaults to
+	// DefaultNotFoundChecks. Ignored when TargetNotFound is set.
+	NotFoundChecks int
+
+	// MinInterval is the delay between the first and the second poll; the first poll happens immediately and the
+	// delay doubles after every poll. Defaults to DefaultMinInterval.
+	MinInterval time.Duration
+	// MaxInterval is the upper bound of the delay between polls. Defaults to DefaultMaxInterval.
+	MaxInterval time.Duration
+}
+
+// Error is returned when a wait ends without the object reaching a target state.
+type Error struct {
+	// Description names the object.
+	Description string
+	// State is the last observed state of the object, empty if it was never observed.
+	State string
+	// Message is the last observed status message of the object.
+	Message string
+	// Timeout is set when the wait ended because the context deadline was exceeded.
+	Timeout bool
+	// Target are the awaited states.
+	Target []string
+}
+
+func (e *Error) Error() string {
+	var b strings.Builder
+	if e.Timeout {
+		fmt.Fprintf(&b, "timeout waiting for %s to be %s", e.Description, strings.Join(e.Target, " or "))
+		if e.State != "" {
+			fmt.Fprintf(&b, " (last state: %s)", e.State)
+		}
+	} else {
+		fmt.Fprintf(&b, "%s is in %s state", e.Description, e.State)
+	}
+	if e.Message != "" {
+		fmt.Fprintf(&b, ": %s", e.Message)
+	}
+	return b.String()
+}
+
+// Wait polls the object until it reaches a target state and returns it. It returns the zero value of T when the
+// object was deleted and TargetNotFound is set. When the object enters a failed or unexpected state, Wait returns
+// it together with an *Error. When the object is not found more often than NotFoundChecks allows, the error wraps
+// the not found error of the refresh function, or ErrNotFound.
+func (w Waiter[T]) Wait(ctx context.Context) (T, error) {
+	var zero T
+	interval := w.MinInterval
+	if interval <= 0 {
+		interval = DefaultMinInterval
+	}
+	maxInterval := w.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = DefaultMaxInterval
+	}
+	notFoundChecks := w.NotFoundChecks
+	if notFoundChecks <= 0 {
+		notFoundChecks = DefaultNotFoundChecks
+	}
+	failed := w.Failed
+	if failed == nil {
+		failed = DefaultFailed
+	}
+
+	var state, message string
+	notFound := 0
+	for {
+		object, err := w.Refresh(ctx)
+		switch {
+		case err != nil && ctx.Err() != nil:
+			return zero, w.contextError(ctx, state, message)
+		case isNotFound(object, err):
+			if w.TargetNotFound {
+				return zero, nil
+			}
+			notFound++
+			if notFound > notFoundChecks {
+				if err != nil {
+					// keep the error of the refresh function, so callers can still check it with tcclient.IsNotFound
+					return zero, fmt.Errorf("%s was not found: %w", w.Description, err)
+				}
+				return zero, fmt.Errorf("%s was %w", w.Description, ErrNotFound)
+			}
+		case err != nil:
+			return zero, err
+		default:
+			notFound = 0
+			state = w.State(object)
+			if w.Message != nil {
+				message = w.Message(object)
+			}
+			if containsState(w.Target, state) {
+				return object, nil
+			}
+			if containsState(failed, state) || (len(w.Pending) > 0 && !containsState(w.Pending, state)) {
+				return object, &Error{Description: w.Description, State: state, Message: message, Target: w.Target}
+			}
+		}
+
+		tflog.Debug(ctx, "waiting for "+w.Description, map[string]any{
+			"state":          state,
+			"target_state":   strings.Join(w.Target, ","),
+			"status_message": message,
+			"next_poll":      interval.String(),
+		})
+
+		timer := time.NewTimer(jitter(interval))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return zero, w.contextError(ctx, state, message)
+		case <-timer.C:
+		}
+		interval = min(interval*2, maxInterval)
+	}
+}
+
+func (w Waiter[T]) contextError(ctx context.Context, state, message string) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		target := w.Target
+		if w.TargetNotFound {
+			target = []string{"deleted"}
+		}
+		return &Error{Description: w.Description, State: state, Message: message, Timeout: true, Target: target}
+	}
+	return ctx.Err()
+}
+
+// jitter returns a random delay between half and all of the interval, so concurrent waits do not poll in lockstep.
+func jitter(interval time.Duration) time.Duration {
+	half := interval / 2
+	return half + rand.N(half+1)
+}
+
+func isNotFound(object any, err error) bool {
+	if err != nil {
+		return errors.Is(err, ErrNotFound) || tcclient.IsNotFound(err)
+	}
+	if object == nil {
+		return true
+	}
+	v := reflect.ValueOf(object)
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice:
+		return v.IsNil()
+	}
+	return false
+}
+
+func containsState(states []string, state string) bool {
+	return slices.ContainsFunc(states, func(s string) bool { return strings.EqualFold(s, state) })
+}
@@ -0,0 +1,140 @@
+package waiter_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	tcclient "github.com/thalassa-cloud/client-go/pkg/client"
+
+	"github.com/thalassa-cloud/terraform-provider-thalassa/thalassa/waiter"
+)
+
+type object struct {
+	status  string
+	message string
+}
+
+// sequence returns a refresh function that returns the given objects in order, repeating the last one.
+func sequence(objects ...*object) (func(context.Context) (*object, error), *int) {
+	calls := 0
+	return func(context.Context) (*object, error) {
+		o := objects[min(calls, len(objects)-1)]
+		calls++
+		return o, nil
+	}, &calls
+}
+
+func newWaiter(refresh func(context.Context) (*object, error)) waiter.Waiter[*object] {
+	return waiter.Waiter[*object]{
+		Description: `object "o-1"`,
+		Refresh:     refresh,
+		State:       func(o *object) string { return o.status },
+		Message:     func(o *object) string { return o.message },
+		Target:      []string{"ready"},
+		MinInterval: time.Millisecond,
+		MaxInterval: 2 * time.Millisecond,
+	}
+}
+
+func TestWaitReachesTarget(t *testing.T) {
+	t.Parallel()
+
+	refresh, calls := sequence(&object{status: "creating"}, &object{status: "provisioning"}, &object{status: "Ready"})
+	result, err := newWaiter(refresh).Wait(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "Ready", result.status)
+	assert.Equal(t, 3, *calls)
+}
+
+func TestWaitFailedStatePropagatesMessage(t *testing.T) {
+	t.Parallel()
+
+	refresh, _ := sequence(&object{status: "creating"}, &object{status: "failed", message: "no capacity in zone"})
+	_, err := newWaiter(refresh).Wait(context.Background())
+
+	var waitErr *waiter.Error
+	assert.True(t, errors.As(err, &waitErr))
+	assert.False(t, waitErr.Timeout)
+	assert.Equal(t, `object "o-1" is in failed state: no capacity in zone`, err.Error())
+}
+
+func TestWaitUnexpectedState(t *testing.T) {
+	t.Parallel()
+
+	refresh, _ := sequence(&object{status: "creating"}, &object{status: "deleting"})
+	w := newWaiter(refresh)
+	w.Pending = []string{"creating"}
+	_, err := w.Wait(context.Background())
+	assert.EqualError(t, err, `object "o-1" is in deleting state`)
+}
+
+func TestWaitTimeout(t *testing.T) {
+	t.Parallel()
+
+	refresh, _ := sequence(&object{status: "creating", message: "waiting for scheduler"})
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := newWaiter(refresh).Wait(ctx)
+
+	var waitErr *waiter.Error
+	assert.True(t, errors.As(err, &waitErr))
+	assert.True(t, waitErr.Timeout)
+	assert.Equal(t, `timeout waiting for object "o-1" to be ready (last state: creating): waiting for scheduler`, err.Error())
+}
+
+func TestWaitNotFound(t *testing.T) {
+	t.Parallel()
+
+	t.Run("tolerates not found results", func(t *testing.T) {
+		t.Parallel()
+		refresh, _ := sequence(nil, nil, &object{status: "ready"})
+		_, err := newWaiter(refresh).Wait(context.Background())
+		assert.NoError(t, err)
+	})
+
+	t.Run("fails after the tolerated not found results", func(t *testing.T) {
+		t.Parallel()
+		refresh, calls := sequence(nil)
+		w := newWaiter(refresh)
+		w.NotFoundChecks = 2
+		_, err := w.Wait(context.Background())
+		assert.EqualError(t, err, `object "o-1" was not found`)
+		assert.ErrorIs(t, err, waiter.ErrNotFound)
+		assert.Equal(t, 3, *calls)
+	})
+
+	t.Run("keeps the not found error of the client", func(t *testing.T) {
+		t.Parallel()
+		calls := 0
+		w := newWaiter(func(context.Context) (*object, error) {
+			calls++
+			return nil, fmt.Errorf("failed to get object: %w", tcclient.ErrNotFound)
+		})
+		w.NotFoundChecks = 2
+		_, err := w.Wait(context.Background())
+		assert.True(t, tcclient.IsNotFound(err))
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("not found is the target of deletions", func(t *testing.T) {
+		t.Parallel()
+		w := newWaiter(func(context.Context) (*object, error) { return nil, waiter.ErrNotFound })
+		w.TargetNotFound = true
+		result, err := w.Wait(context.Background())
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestWaitRefreshError(t *testing.T) {
+	t.Parallel()
+
+	w := newWaiter(func(context.Context) (*object, error) { return nil, errors.New("internal server error") })
+	_, err := w.Wait(context.Background())
+	assert.EqualError(t, err, "internal server error")
+}